@@ -6,41 +6,26 @@ package cast
 
 import (
 	"reflect"
-	"slices"
 )
 
-var kindNames = []string{
-	reflect.String:  "string",
-	reflect.Bool:    "bool",
-	reflect.Int:     "int",
-	reflect.Int8:    "int8",
-	reflect.Int16:   "int16",
-	reflect.Int32:   "int32",
-	reflect.Int64:   "int64",
-	reflect.Uint:    "uint",
-	reflect.Uint8:   "uint8",
-	reflect.Uint16:  "uint16",
-	reflect.Uint32:  "uint32",
-	reflect.Uint64:  "uint64",
-	reflect.Float32: "float32",
-	reflect.Float64: "float64",
-}
-
-var kinds = map[reflect.Kind]func(reflect.Value) any{
-	reflect.String:  func(v reflect.Value) any { return v.String() },
-	reflect.Bool:    func(v reflect.Value) any { return v.Bool() },
-	reflect.Int:     func(v reflect.Value) any { return int(v.Int()) },
-	reflect.Int8:    func(v reflect.Value) any { return int8(v.Int()) },
-	reflect.Int16:   func(v reflect.Value) any { return int16(v.Int()) },
-	reflect.Int32:   func(v reflect.Value) any { return int32(v.Int()) },
-	reflect.Int64:   func(v reflect.Value) any { return v.Int() },
-	reflect.Uint:    func(v reflect.Value) any { return uint(v.Uint()) },
-	reflect.Uint8:   func(v reflect.Value) any { return uint8(v.Uint()) },
-	reflect.Uint16:  func(v reflect.Value) any { return uint16(v.Uint()) },
-	reflect.Uint32:  func(v reflect.Value) any { return uint32(v.Uint()) },
-	reflect.Uint64:  func(v reflect.Value) any { return v.Uint() },
-	reflect.Float32: func(v reflect.Value) any { return float32(v.Float()) },
-	reflect.Float64: func(v reflect.Value) any { return v.Float() },
+// kinds maps the supported kinds to their basic types.
+var kinds = map[reflect.Kind]reflect.Type{
+	reflect.String:     reflect.TypeOf(""),
+	reflect.Bool:       reflect.TypeOf(false),
+	reflect.Int:        reflect.TypeOf(int(0)),
+	reflect.Int8:       reflect.TypeOf(int8(0)),
+	reflect.Int16:      reflect.TypeOf(int16(0)),
+	reflect.Int32:      reflect.TypeOf(int32(0)),
+	reflect.Int64:      reflect.TypeOf(int64(0)),
+	reflect.Uint:       reflect.TypeOf(uint(0)),
+	reflect.Uint8:      reflect.TypeOf(uint8(0)),
+	reflect.Uint16:     reflect.TypeOf(uint16(0)),
+	reflect.Uint32:     reflect.TypeOf(uint32(0)),
+	reflect.Uint64:     reflect.TypeOf(uint64(0)),
+	reflect.Float32:    reflect.TypeOf(float32(0)),
+	reflect.Float64:    reflect.TypeOf(float64(0)),
+	reflect.Complex64:  reflect.TypeOf(complex64(0)),
+	reflect.Complex128: reflect.TypeOf(complex128(0)),
 }
 
 // resolveAlias attempts to resolve a named type to its underlying basic type (if possible).
@@ -53,17 +38,15 @@ func resolveAlias(i any) (any, bool) {
 
 	t := reflect.TypeOf(i)
 
-	// Not a named type
-	if t.Name() == "" || slices.Contains(kindNames, t.Name()) {
+	kt, ok := kinds[t.Kind()]
+	if !ok { // Not a supported kind
 		return i, false
 	}
 
-	resolve, ok := kinds[t.Kind()]
-	if !ok { // Not a supported kind
+	// Not a named type
+	if t == kt {
 		return i, false
 	}
 
-	v := reflect.ValueOf(i)
-
-	return resolve(v), true
+	return reflect.ValueOf(i).Convert(kt).Interface(), true
 }
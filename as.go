@@ -0,0 +1,473 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"regexp"
+	"time"
+)
+
+var (
+	errUnsupportedType    = errors.New("unsupported target type")
+	errInvalidDestination = errors.New("destination must be a non-nil pointer or a settable reflect.Value")
+	errOverflow           = errors.New("value out of range")
+)
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// leafConverter returns the function converting values to t, if t is supported by the individual To*E functions.
+func leafConverter(t reflect.Type) (func(i any, c config) (any, error), bool) {
+	switch t {
+	case reflect.TypeOf(""):
+		return convertBasic[string], true
+	case reflect.TypeOf(false):
+		return convertBasic[bool], true
+	case reflect.TypeOf(int(0)):
+		return convertBasic[int], true
+	case reflect.TypeOf(int8(0)):
+		return convertBasic[int8], true
+	case reflect.TypeOf(int16(0)):
+		return convertBasic[int16], true
+	case reflect.TypeOf(int32(0)):
+		return convertBasic[int32], true
+	case reflect.TypeOf(int64(0)):
+		return convertBasic[int64], true
+	case reflect.TypeOf(uint(0)):
+		return convertBasic[uint], true
+	case reflect.TypeOf(uint8(0)):
+		return convertBasic[uint8], true
+	case reflect.TypeOf(uint16(0)):
+		return convertBasic[uint16], true
+	case reflect.TypeOf(uint32(0)):
+		return convertBasic[uint32], true
+	case reflect.TypeOf(uint64(0)):
+		return convertBasic[uint64], true
+	case reflect.TypeOf(float32(0)):
+		return convertBasic[float32], true
+	case reflect.TypeOf(float64(0)):
+		return convertBasic[float64], true
+	case reflect.TypeOf(complex64(0)):
+		return convertBasic[complex64], true
+	case reflect.TypeOf(complex128(0)):
+		return convertBasic[complex128], true
+	case reflect.TypeOf(time.Time{}):
+		return convertBasic[time.Time], true
+	case reflect.TypeOf(time.Duration(0)):
+		return convertBasic[time.Duration], true
+	case reflect.TypeOf([]byte(nil)):
+		return func(i any, c config) (any, error) { return toBytesE(i, c) }, true
+	case reflect.TypeOf(fs.FileMode(0)):
+		return func(i any, _ config) (any, error) { return ToFileModeE(i) }, true
+	case reflect.TypeOf(net.IP(nil)):
+		return func(i any, _ config) (any, error) { return ToIPE(i) }, true
+	case reflect.TypeOf(netip.Addr{}):
+		return func(i any, _ config) (any, error) { return ToAddrE(i) }, true
+	case reflect.TypeOf(netip.Prefix{}):
+		return func(i any, _ config) (any, error) { return ToPrefixE(i) }, true
+	case reflect.TypeOf(netip.AddrPort{}):
+		return func(i any, _ config) (any, error) { return ToAddrPortE(i) }, true
+	case reflect.TypeOf((*url.URL)(nil)):
+		return func(i any, _ config) (any, error) { return ToURLE(i) }, true
+	case reflect.TypeOf((*regexp.Regexp)(nil)):
+		return func(i any, c config) (any, error) { return toRegexpE(i, c) }, true
+	default:
+		return nil, false
+	}
+}
+
+// normalizes reports whether inputs are normalized before converting them to t (see [WithNormalization]).
+func normalizes(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.String, reflect.Interface:
+		return false
+	case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+		_, ok := leafConverter(t)
+
+		return ok
+	default:
+		return true
+	}
+}
+
+// checkOverflow makes sure i fits in the numeric type t: unlike the To*E functions,
+// conversions to types narrower than 64 bits (like uint16) fail instead of truncating the value.
+//
+// Conversion errors are left to the actual conversion.
+func checkOverflow(i any, t reflect.Type, c config) error {
+	z := reflect.Zero(t)
+
+	var overflow bool
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		v, err := toE[int64](i, c)
+		overflow = err == nil && z.OverflowInt(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		v, err := toE[uint64](i, c)
+		overflow = err == nil && z.OverflowUint(v)
+	case reflect.Float32:
+		v, err := toE[float64](i, c)
+		overflow = err == nil && z.OverflowFloat(v)
+	case reflect.Complex64:
+		v, err := toE[complex128](i, c)
+		overflow = err == nil && z.OverflowComplex(v)
+	}
+
+	if overflow {
+		return fmt.Errorf(errorMsgWith, i, i, z.Interface(), errOverflow)
+	}
+
+	return nil
+}
+
+func convertBasic[T Basic](i any, c config) (any, error) {
+	return toE[T](i, c)
+}
+
+// AsE casts any value to type T.
+//
+// T may be any type supported by the To*E functions (e.g. [Basic] types, [net.IP] or [*url.URL]),
+// named types based on [Basic] types (e.g. type Port uint16), enum types (see [RegisterEnum]),
+// types implementing [encoding.TextUnmarshaler] or slices, arrays, maps and pointers of these types (recursively),
+// like []int or map[string]time.Duration.
+//
+// Unlike the To*E functions (including [ToE] with named types), numbers that do not fit in the target type
+// (like 70000 for a uint16 or type Port uint16) result in an error instead of being truncated.
+//
+// Like [ToSliceOfE] and [ToMapE], it accepts [Option] values that are applied to every conversion.
+func AsE[T any](i any, opts ...Option) (T, error) {
+	var t T
+
+	v, err := convertValue(i, reflect.TypeOf(&t).Elem(), newAsConfig(opts))
+	if err != nil {
+		return t, err
+	}
+
+	// Nil values of interface types cannot be asserted
+	t, _ = v.Interface().(T)
+
+	return t, nil
+}
+
+// As casts any value to type T (see [AsE]).
+func As[T any](i any) T {
+	v, _ := AsE[T](i)
+
+	return v
+}
+
+// Into casts src to the type dst points to and stores the result in it (see [AsE] for the supported types).
+//
+// dst must be a non-nil pointer (e.g. a pointer to a struct field of a named type like type Port uint16)
+// or a settable [reflect.Value]. It is left unchanged if the conversion fails.
+func Into(dst any, src any, opts ...Option) error {
+	v, ok := dst.(reflect.Value)
+	if !ok {
+		v = reflect.ValueOf(dst)
+		if v.Kind() != reflect.Pointer || v.IsNil() {
+			return fmt.Errorf("%w, got %T", errInvalidDestination, dst)
+		}
+
+		v = v.Elem()
+	}
+
+	if !v.IsValid() || !v.CanSet() {
+		return errInvalidDestination
+	}
+
+	r, err := convertValue(src, v.Type(), newAsConfig(opts))
+	if err != nil {
+		return err
+	}
+
+	v.Set(r)
+
+	return nil
+}
+
+// newAsConfig returns the configuration of AsE and Into, which (unlike the To*E functions) reject overflowing numbers.
+func newAsConfig(opts []Option) config {
+	c := newConfig(opts)
+	c.rejectOverflow = true
+
+	return c
+}
+
+// convertValue converts i to type t.
+func convertValue(i any, t reflect.Type, c config) (reflect.Value, error) {
+	// Strings are not normalized (like in ToE), composite values are normalized element by element
+	if c.normalize && normalizes(t) {
+		i = c.normalizeInput(i)
+	}
+
+	if e, ok := lookupEnum(t); ok {
+		return e.convert(i, t, c)
+	}
+
+	if i != nil && reflect.TypeOf(i) == t {
+		return reflect.ValueOf(i), nil
+	}
+
+	if fn, ok := leafConverter(t); ok {
+		if c.rejectOverflow {
+			if err := checkOverflow(i, t, c); err != nil {
+				return reflect.Zero(t), err
+			}
+		}
+
+		v, err := fn(i, c)
+		if err != nil {
+			return reflect.Zero(t), err
+		}
+
+		if v == nil {
+			return reflect.Zero(t), nil
+		}
+
+		return reflect.ValueOf(v), nil
+	}
+
+	if t.Kind() == reflect.Pointer {
+		return convertPointer(i, t, c)
+	}
+
+	i, _ = indirect(i)
+
+	if reflect.PointerTo(t).Implements(textUnmarshalerType) && (isText(i) || kinds[t.Kind()] == nil) {
+		return convertText(i, t, c)
+	}
+
+	if kt, ok := kinds[t.Kind()]; ok {
+		// Named types based on basic types
+		v, err := convertValue(i, kt, c)
+		if err != nil {
+			return reflect.Zero(t), err
+		}
+
+		return v.Convert(t), nil
+	}
+
+	switch t.Kind() {
+	case reflect.Slice:
+		return convertSlice(i, t, c)
+	case reflect.Array:
+		return convertArray(i, t, c)
+	case reflect.Map:
+		return convertReflectMapTo(i, t, c)
+	case reflect.Interface:
+		if i == nil {
+			return reflect.Zero(t), nil
+		}
+
+		if reflect.TypeOf(i).Implements(t) {
+			v := reflect.New(t).Elem()
+			v.Set(reflect.ValueOf(i))
+
+			return v, nil
+		}
+	}
+
+	return reflect.Zero(t), fmt.Errorf(errorMsgWith, i, i, reflect.Zero(t).Interface(), errUnsupportedType)
+}
+
+// convertPointer converts i to the element type of t and returns a pointer to the result.
+//
+// Nil inputs (including nil pointers and invalid (NULL) [database/sql/driver.Valuer] values) result in a nil pointer.
+func convertPointer(i any, t reflect.Type, c config) (reflect.Value, error) {
+	if isNil(i) {
+		return reflect.Zero(t), nil
+	}
+
+	v, err := convertValue(i, t.Elem(), c)
+	if err != nil {
+		return reflect.Zero(t), err
+	}
+
+	p := reflect.New(t.Elem())
+	p.Elem().Set(v)
+
+	return p, nil
+}
+
+// isText reports whether i is a textual value (a string or a byte slice, including named types).
+func isText(i any) bool {
+	if i == nil {
+		return false
+	}
+
+	k := reflect.TypeOf(i).Kind()
+
+	return k == reflect.String || k == reflect.Slice && reflect.TypeOf(i).Elem().Kind() == reflect.Uint8
+}
+
+// convertText converts i to type t (implementing [encoding.TextUnmarshaler]) like [ToTextE].
+func convertText(i any, t reflect.Type, c config) (reflect.Value, error) {
+	p := reflect.New(t)
+
+	s, err := toStringE(i, c)
+	if err != nil {
+		return reflect.Zero(t), fmt.Errorf(errorMsgWith, i, i, p.Elem().Interface(), err)
+	}
+
+	if err := p.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s)); err != nil {
+		return reflect.Zero(t), fmt.Errorf(errorMsgWith, i, i, p.Elem().Interface(), err)
+	}
+
+	return p.Elem(), nil
+}
+
+// convertSlice converts the elements of a slice or array to the element type of the slice type t.
+func convertSlice(i any, t reflect.Type, c config) (reflect.Value, error) {
+	src, ok := sliceValue(i)
+	if !ok {
+		return reflect.Zero(t), fmt.Errorf(errorMsg, i, i, reflect.Zero(t).Interface())
+	}
+
+	s := reflect.MakeSlice(t, src.Len(), src.Len())
+
+	if err := convertElements(s, src, i, c); err != nil {
+		return reflect.Zero(t), err
+	}
+
+	return s, nil
+}
+
+// convertArray converts the elements of a slice or array (of the same length) to the element type of the array type t.
+func convertArray(i any, t reflect.Type, c config) (reflect.Value, error) {
+	a := reflect.New(t).Elem()
+
+	src, ok := sliceValue(i)
+	if !ok {
+		return a, fmt.Errorf(errorMsg, i, i, a.Interface())
+	}
+
+	if src.Len() != t.Len() {
+		return a, fmt.Errorf(errorMsgWith, i, i, a.Interface(), fmt.Errorf("length %d does not match array length %d", src.Len(), t.Len()))
+	}
+
+	if err := convertElements(a, src, i, c); err != nil {
+		return reflect.New(t).Elem(), err
+	}
+
+	return a, nil
+}
+
+// sliceValue returns the value of i if it is a slice or an array.
+func sliceValue(i any) (reflect.Value, bool) {
+	if i == nil {
+		return reflect.Value{}, false
+	}
+
+	v := reflect.ValueOf(i)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return reflect.Value{}, false
+	}
+
+	return v, true
+}
+
+// convertElements converts the elements of src into dst (a slice or array of the same length).
+//
+// Conversion stops at the first failing element unless allErrors is set.
+// Every failure is reported as an [IndexError].
+func convertElements(dst reflect.Value, src reflect.Value, i any, c config) error {
+	var errs []error
+
+	for j := 0; j < src.Len(); j++ {
+		e := src.Index(j).Interface()
+
+		v, err := convertValue(e, dst.Type().Elem(), c)
+		if err != nil {
+			errs = append(errs, &IndexError{Index: j, Value: e, Err: err})
+
+			if !c.allErrors {
+				break
+			}
+
+			continue
+		}
+
+		dst.Index(j).Set(v)
+	}
+
+	return wrapErrors(i, reflect.Zero(dst.Type()).Interface(), errs)
+}
+
+// convertReflectMapTo converts the entries of a map (or an ordered map or a JSON object) to the map type t.
+//
+// Every failure is reported as a [KeyError].
+func convertReflectMapTo(i any, t reflect.Type, c config) (reflect.Value, error) {
+	zero := reflect.Zero(t)
+
+	if i == nil {
+		return zero, fmt.Errorf(errorMsg, i, i, zero.Interface())
+	}
+
+	if s, ok := i.(string); ok {
+		var m map[string]any
+		if err := jsonStringToObject(s, &m); err != nil {
+			return zero, fmt.Errorf(errorMsgWith, i, i, zero.Interface(), err)
+		}
+
+		i = m
+	}
+
+	m := reflect.MakeMap(t)
+
+	var errs []error
+
+	set := func(k any, val any) bool {
+		key, err := convertValue(k, t.Key(), c)
+		if err == nil {
+			var value reflect.Value
+
+			value, err = convertValue(val, t.Elem(), c)
+			if err == nil {
+				m.SetMapIndex(key, value)
+
+				return true
+			}
+		}
+
+		errs = append(errs, &KeyError{Key: k, Err: err})
+
+		return c.allErrors
+	}
+
+	if entries, ok := orderedEntries(i); ok {
+		for _, e := range entries {
+			if !set(e.Key, e.Value) {
+				break
+			}
+		}
+	} else {
+		v := reflect.ValueOf(i)
+		if v.Kind() != reflect.Map {
+			return zero, fmt.Errorf(errorMsg, i, i, zero.Interface())
+		}
+
+		iter := v.MapRange()
+		for iter.Next() {
+			if !set(iter.Key().Interface(), iter.Value().Interface()) {
+				break
+			}
+		}
+	}
+
+	if err := wrapErrors(i, zero.Interface(), errs); err != nil {
+		return zero, err
+	}
+
+	return m, nil
+}
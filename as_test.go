@@ -0,0 +1,217 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast_test
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+
+	"github.com/spf13/cast"
+)
+
+type port uint16
+
+type ports []port
+
+func TestAs(t *testing.T) {
+	c := qt.New(t)
+
+	eight := 8
+
+	assertAs(c, "8", 8)
+	assertAs(c, "8", port(8))
+	assertAs(c, MyInt(8), port(8))
+	assertAs(c, []string{"1", "2"}, []int{1, 2})
+	assertAs(c, []any{"1", 2.5}, []int{1, 2})
+	assertAs(c, [2]string{"1", "2"}, []int{1, 2})
+	assertAs(c, []string{"1", "2"}, [2]int{1, 2})
+	assertAs(c, []string{"80", "443"}, ports{80, 443})
+	assertAs(c, []any{[]string{"1"}, []int{2, 3}}, [][]int{{1}, {2, 3}})
+	assertAs(c, map[string]any{"a": "1s", "b": 60}, map[string]time.Duration{"a": time.Second, "b": 60})
+	assertAs(c, map[any]any{1: "true"}, map[string]bool{"1": true})
+	assertAs(c, `{"a": [1, 2]}`, map[string][]uint8{"a": {1, 2}})
+	assertAs(c, orderedMap{keys: []string{"a"}, values: map[string]any{"a": "1"}}, map[string]int{"a": 1})
+	assertAs(c, []mapItem{{"a", "1"}}, map[string]int{"a": 1})
+	assertAs(c, "8", &eight)
+	assertAs(c, []string{"1", "2"}, []*int{ptrTo(1), ptrTo(2)})
+	assertAs(c, []any{"1", nil}, []*int{ptrTo(1), nil})
+	assertAs(c, nil, (*int)(nil))
+	assertAs(c, "secret", []byte("secret"))
+	assertAs(c, []string{"10.0.0.1"}, []netip.Addr{netip.MustParseAddr("10.0.0.1")})
+	assertAs(c, "warning", level(2))
+	assertAs(c, 2, level(2))
+	assertAs(c, []string{"debug", "error"}, []level{0, 3})
+	assertAs(c, 8, any(8))
+	assertAs(c, fu{val: "bar"}, error(fu{val: "bar"}))
+	assertAs(c, "255", uint8(255))
+	assertAs(c, -128, int8(-128))
+	assertAs(c, "65535", port(65535))
+
+	u, err := cast.AsE[*url.URL]("https://example.com")
+	c.Assert(err, qt.IsNil)
+	c.Assert(u.Host, qt.Equals, "example.com")
+
+	v, err := cast.AsE[any](nil)
+	c.Assert(err, qt.IsNil)
+	c.Assert(v, qt.IsNil)
+
+	// Options
+	s, err := cast.AsE[[]int]([]string{" 1 ", "２"}, cast.WithNormalization())
+	c.Assert(err, qt.IsNil)
+	c.Assert(s, qt.DeepEquals, []int{1, 2})
+
+	// Normalization applies to every target type (except strings)
+	addr, err := cast.AsE[netip.Addr](" 1.2.3.4 ", cast.WithNormalization())
+	c.Assert(err, qt.IsNil)
+	c.Assert(addr, qt.Equals, netip.MustParseAddr("1.2.3.4"))
+
+	mode, err := cast.AsE[fs.FileMode](" 0644 ", cast.WithNormalization())
+	c.Assert(err, qt.IsNil)
+	c.Assert(mode, qt.Equals, fs.FileMode(0o644))
+
+	p, err := cast.AsE[port]("８０", cast.WithNormalization())
+	c.Assert(err, qt.IsNil)
+	c.Assert(p, qt.Equals, port(80))
+
+	l, err := cast.AsE[level](" ｗａｒｎｉｎｇ ", cast.WithNormalization())
+	c.Assert(err, qt.IsNil)
+	c.Assert(l, qt.Equals, level(2))
+
+	str, err := cast.AsE[string](" 1 ", cast.WithNormalization())
+	c.Assert(err, qt.IsNil)
+	c.Assert(str, qt.Equals, " 1 ")
+
+	u, err = cast.AsE[*url.URL](u, cast.WithNormalization())
+	c.Assert(err, qt.IsNil)
+	c.Assert(u.Host, qt.Equals, "example.com")
+
+	c.Assert(cast.As[[]int]([]string{"1", "foo"}), qt.IsNil)
+}
+
+func TestAsErrors(t *testing.T) {
+	c := qt.New(t)
+
+	_, err := cast.AsE[[]int]([]any{1, "foo", 3, "bar"}, cast.WithAllErrors())
+	c.Assert(err, qt.IsNotNil)
+
+	var indexErr *cast.IndexError
+	c.Assert(errors.As(err, &indexErr), qt.IsTrue)
+	c.Assert(indexErr.Index, qt.Equals, 1)
+
+	joined, ok := errors.Unwrap(err).(interface{ Unwrap() []error })
+	c.Assert(ok, qt.IsTrue)
+	c.Assert(joined.Unwrap(), qt.HasLen, 2)
+
+	_, err = cast.AsE[map[string]int](map[string]any{"a": "foo"})
+	c.Assert(err, qt.IsNotNil)
+
+	var keyErr *cast.KeyError
+	c.Assert(errors.As(err, &keyErr), qt.IsTrue)
+	c.Assert(keyErr.Key, qt.Equals, "a")
+
+	for _, fn := range []func() error{
+		func() error { _, err := cast.AsE[[]int](nil); return err },
+		func() error { _, err := cast.AsE[[]int]("1 2"); return err },
+		func() error { _, err := cast.AsE[[3]int]([]int{1, 2}); return err },
+		func() error { _, err := cast.AsE[map[string]int](nil); return err },
+		func() error { _, err := cast.AsE[map[string]int](8); return err },
+		func() error { _, err := cast.AsE[port]("foo"); return err },
+		func() error { _, err := cast.AsE[struct{ A int }](map[string]any{"A": 1}); return err },
+		func() error { _, err := cast.AsE[chan int](nil); return err },
+		func() error { _, err := cast.AsE[fmt.Stringer](8); return err },
+		func() error { _, err := cast.AsE[level]("unknown"); return err },
+		func() error { _, err := cast.AsE[port]("70000"); return err },
+		func() error { _, err := cast.AsE[uint8](256); return err },
+		func() error { _, err := cast.AsE[int8]("-129"); return err },
+		func() error { _, err := cast.AsE[float32](1e300); return err },
+		func() error { _, err := cast.AsE[[]uint8]([]int{1, 300}); return err },
+	} {
+		c.Assert(fn(), qt.IsNotNil)
+	}
+
+	// The To*E functions truncate numbers instead, for named types as well
+	u, err := cast.ToE[uint16](70000)
+	c.Assert(err, qt.IsNil)
+	c.Assert(u, qt.Equals, uint16(4464))
+
+	p, err := cast.ToE[port](70000)
+	c.Assert(err, qt.IsNil)
+	c.Assert(p, qt.Equals, port(4464))
+}
+
+func assertAs[T any](c *qt.C, input any, expected T) {
+	c.Helper()
+
+	v, err := cast.AsE[T](input)
+	c.Assert(err, qt.IsNil)
+	assertDeepEqual(c, v, expected)
+
+	assertDeepEqual(c, cast.As[T](input), expected)
+}
+
+func TestInto(t *testing.T) {
+	c := qt.New(t)
+
+	var config struct {
+		Port    port
+		Hosts   []string
+		Timeout *time.Duration
+		Labels  map[string]int
+		Level   level
+		Any     any
+	}
+
+	c.Assert(cast.Into(&config.Port, "8080"), qt.IsNil)
+	c.Assert(config.Port, qt.Equals, port(8080))
+
+	c.Assert(cast.Into(&config.Hosts, []any{"a", 1}), qt.IsNil)
+	c.Assert(config.Hosts, qt.DeepEquals, []string{"a", "1"})
+
+	c.Assert(cast.Into(&config.Timeout, "1m"), qt.IsNil)
+	c.Assert(*config.Timeout, qt.Equals, time.Minute)
+
+	c.Assert(cast.Into(&config.Labels, map[string]any{"a": " 1 "}, cast.WithNormalization()), qt.IsNil)
+	c.Assert(config.Labels, qt.DeepEquals, map[string]int{"a": 1})
+
+	c.Assert(cast.Into(&config.Any, 8), qt.IsNil)
+	c.Assert(config.Any, qt.Equals, 8)
+
+	// Reflection
+	v := reflect.ValueOf(&config).Elem()
+
+	c.Assert(cast.Into(v.FieldByName("Level"), "error"), qt.IsNil)
+	c.Assert(config.Level, qt.Equals, level(3))
+
+	c.Assert(cast.Into(v.FieldByName("Port").Addr().Interface(), 443), qt.IsNil)
+	c.Assert(config.Port, qt.Equals, port(443))
+
+	// The destination is left unchanged on failure
+	c.Assert(cast.Into(&config.Port, "foo"), qt.IsNotNil)
+	c.Assert(config.Port, qt.Equals, port(443))
+
+	c.Assert(cast.Into(&config.Port, -1), qt.IsNotNil)
+	c.Assert(config.Port, qt.Equals, port(443))
+
+	c.Assert(cast.Into(&config.Port, "70000"), qt.IsNotNil)
+	c.Assert(config.Port, qt.Equals, port(443))
+
+	// Invalid destinations
+	var p *int
+
+	c.Assert(cast.Into(config.Port, 8), qt.ErrorMatches, "destination must be .*, got cast_test.port")
+	c.Assert(cast.Into(p, 8), qt.IsNotNil)
+	c.Assert(cast.Into(nil, 8), qt.IsNotNil)
+	c.Assert(cast.Into(reflect.ValueOf(config).FieldByName("Port"), 8), qt.IsNotNil)
+	c.Assert(cast.Into(reflect.Value{}, 8), qt.IsNotNil)
+}
@@ -6,9 +6,12 @@
 package cast
 
 import (
+	"encoding"
 	"encoding/json"
 	"fmt"
 	"html/template"
+	"net"
+	"net/url"
 	"strconv"
 	"time"
 )
@@ -62,12 +65,54 @@ func ToBoolE(i any) (bool, error) {
 			return ToBoolE(i)
 		}
 
+		if i, ok := indirectValuer(i); ok {
+			return ToBoolE(i)
+		}
+
 		return false, fmt.Errorf(errorMsg, i, i, false)
 	}
 }
 
 // ToStringE casts any value to a string type.
+//
+// Non-finite floats are converted to "NaN", "+Inf" and "-Inf" (as formatted by [strconv.FormatFloat]),
+// which are accepted by the number conversions unless [WithFiniteFloats] is used.
 func ToStringE(i any) (string, error) {
+	return toStringE(i, config{})
+}
+
+// ToStringWithE casts any value to a string type using the given options.
+func ToStringWithE(i any, opts ...Option) (string, error) {
+	return toStringE(i, newConfig(opts))
+}
+
+func toStringE(i any, c config) (string, error) {
+	if c.runeText {
+		switch s := i.(type) {
+		case rune:
+			return string(s), nil
+		case []rune:
+			return string(s), nil
+		}
+	}
+
+	if name, ok := enumName(i); ok {
+		return name, nil
+	}
+
+	if c.strictString && !isStringlike(i) {
+		// Named types are converted by their underlying value
+		if v, ok := resolveAlias(i); ok {
+			return toStringE(v, c)
+		}
+
+		return "", fmt.Errorf(errorMsg, i, i, "")
+	}
+
+	if v, ok, err := c.formatNumber(i); ok {
+		return v, err
+	}
+
 	switch s := i.(type) {
 	case string:
 		return s, nil
@@ -77,6 +122,10 @@ func ToStringE(i any) (string, error) {
 		return strconv.FormatFloat(s, 'f', -1, 64), nil
 	case float32:
 		return strconv.FormatFloat(float64(s), 'f', -1, 32), nil
+	case complex128:
+		return strconv.FormatComplex(s, 'f', -1, 128), nil
+	case complex64:
+		return strconv.FormatComplex(complex128(s), 'f', -1, 64), nil
 	case int:
 		return strconv.Itoa(s), nil
 	case int8:
@@ -100,7 +149,7 @@ func ToStringE(i any) (string, error) {
 	case json.Number:
 		return s.String(), nil
 	case []byte:
-		return string(s), nil
+		return encodeBytes(s, c.encoding), nil
 	case template.HTML:
 		return string(s), nil
 	case template.URL:
@@ -113,19 +162,62 @@ func ToStringE(i any) (string, error) {
 		return string(s), nil
 	case nil:
 		return "", nil
+	case url.URL:
+		return s.String(), nil
+	case net.IPNet:
+		return s.String(), nil
+	case time.Time:
+		// Keep the [time.Time.String] representation instead of MarshalText for backwards compatibility.
+		return s.String(), nil
+	case encoding.TextMarshaler:
+		b, err := s.MarshalText()
+		if err == nil {
+			return string(b), nil
+		}
+
+		// Fall back to the other representations of the value
+		switch s := i.(type) {
+		case fmt.Stringer:
+			return s.String(), nil
+		case error:
+			return s.Error(), nil
+		}
+
+		if i, ok := resolveAlias(i); ok {
+			return toStringE(i, c)
+		}
+
+		return "", fmt.Errorf(errorMsgWith, i, i, "", err)
 	case fmt.Stringer:
 		return s.String(), nil
 	case error:
 		return s.Error(), nil
 	default:
 		if i, ok := indirect(i); ok {
-			return ToStringE(i)
+			return toStringE(i, c)
 		}
 
 		if i, ok := resolveAlias(i); ok {
-			return ToStringE(i)
+			return toStringE(i, c)
+		}
+
+		if i, ok := indirectValuer(i); ok {
+			return toStringE(i, c)
 		}
 
 		return "", fmt.Errorf(errorMsg, i, i, "")
 	}
 }
+
+// isStringlike reports whether i is a scalar value accepted by strict string conversions (see [WithStrictString]).
+func isStringlike(i any) bool {
+	switch i.(type) {
+	case nil, string, []byte, json.Number, bool,
+		int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64,
+		float32, float64, complex64, complex128,
+		template.HTML, template.URL, template.JS, template.CSS, template.HTMLAttr:
+		return true
+	default:
+		return false
+	}
+}
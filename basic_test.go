@@ -6,7 +6,9 @@
 package cast_test
 
 import (
+	"database/sql"
 	"encoding/json"
+	"fmt"
 	"html/template"
 	"testing"
 	"time"
@@ -83,6 +85,13 @@ func TestBool(t *testing.T) {
 		// Alias
 		{MyBool(true), true, false},
 		{MyBool(false), false, false},
+		{MyStatus(1), true, false},
+
+		// database/sql
+		{sql.NullBool{Bool: true, Valid: true}, true, false},
+		{sql.NullBool{Bool: true}, false, false},
+		{sql.NullInt64{Int64: 1, Valid: true}, true, false},
+		{sql.NullString{String: "true", Valid: true}, true, false},
 
 		// Failure cases
 		{"test", false, true},
@@ -121,6 +130,8 @@ func TestString(t *testing.T) {
 		{uint64(8), "8", false},
 		{float32(8.31), "8.31", false},
 		{float64(8.31), "8.31", false},
+		{complex(1, 2), "(1+2i)", false},
+		{complex64(complex(8.31, -1)), "(8.31-1i)", false},
 		{json.Number("8"), "8", false},
 		{true, "true", false},
 		{false, "false", false},
@@ -136,11 +147,24 @@ func TestString(t *testing.T) {
 
 		// Alias
 		{MyString("foo"), "foo", false},
+		{MyStatus(8), "8", false},
+
+		// database/sql
+		{sql.NullString{String: "foo", Valid: true}, "foo", false},
+		{sql.NullString{String: "foo"}, "", false},
+		{sql.NullInt32{Int32: 8, Valid: true}, "8", false},
+		{sql.NullFloat64{Float64: 8.31, Valid: true}, "8.31", false},
 
 		// Stringer and error
 		{foo{val: "bar"}, "bar", false},
 		{fu{val: "bar"}, "bar", false},
 
+		// TextMarshaler (preferred over Stringer)
+		{level(2), "warning", false},
+		{level(9), "level(9)", false},
+		{rawLevel(9), "9", false},
+		{time.Date(2009, 11, 10, 23, 0, 0, 0, time.UTC), "2009-11-10 23:00:00 +0000 UTC", false},
+
 		// Failure cases
 		{testing.T{}, "", true},
 		{key, "", true},
@@ -149,6 +173,66 @@ func TestString(t *testing.T) {
 	runTests(t, testCases, cast.ToString, cast.ToStringE)
 }
 
+func TestStringStrict(t *testing.T) {
+	var ptr *string
+
+	str := "foo"
+
+	toStringWith := func(opts ...cast.Option) func(i any) (string, error) {
+		return func(i any) (string, error) {
+			return cast.ToStringWithE(i, opts...)
+		}
+	}
+
+	t.Run("Strict", func(t *testing.T) {
+		testCases := []testCase{
+			{"foo", "foo", false},
+			{[]byte("foo"), "foo", false},
+			{json.Number("8"), "8", false},
+			{8, "8", false},
+			{8.31, "8.31", false},
+			{true, "true", false},
+			{template.HTML("<b>"), "<b>", false},
+			{nil, "", false},
+			{MyString("foo"), "foo", false},
+			{level(2), "2", false},
+			{time.Second, "1000000000", false},
+
+			// Failure cases
+			{foo{val: "bar"}, "", true},
+			{fu{val: "bar"}, "", true},
+			{&str, "", true},
+			{ptr, "", true},
+			{sql.NullString{String: "foo", Valid: true}, "", true},
+			{testing.T{}, "", true},
+		}
+
+		runConversionTests(t, testCases, nil, toStringWith(cast.WithStrictString()))
+	})
+
+	t.Run("ExactFloats", func(t *testing.T) {
+		exact := cast.WithExactFloats()
+
+		runConversionTests(t, []testCase{
+			{8.31, "8.31", false},
+			{float32(8.31), "8.31", false},
+		}, nil, toStringWith(exact))
+
+		runConversionTests(t, []testCase{
+			{0.5, "0.50", false},
+			{3.14159, "", true},
+		}, nil, toStringWith(exact, cast.WithFloatFormat('f', 2)))
+
+		runConversionTests(t, []testCase{
+			{float32(1.0 / 3), "", true},
+		}, nil, toStringWith(exact, cast.WithFloatFormat('e', 3)))
+
+		runConversionTests(t, []testCase{
+			{1234.5, "1.234,5", false},
+		}, nil, toStringWith(exact, cast.WithNumberFormat(cast.NumberFormatGerman)))
+	})
+}
+
 type foo struct {
 	val string
 }
@@ -164,3 +248,38 @@ type fu struct {
 func (x fu) Error() string {
 	return x.val
 }
+
+type level int
+
+var levelNames = []string{"debug", "info", "warning", "error"}
+
+func (l level) String() string {
+	return fmt.Sprintf("level(%d)", int(l))
+}
+
+func (l level) MarshalText() ([]byte, error) {
+	if l < 0 || int(l) >= len(levelNames) {
+		return nil, fmt.Errorf("invalid level %d", int(l))
+	}
+
+	return []byte(levelNames[l]), nil
+}
+
+func (l *level) UnmarshalText(text []byte) error {
+	for i, name := range levelNames {
+		if name == string(text) {
+			*l = level(i)
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown level %q", text)
+}
+
+// rawLevel has no String method to fall back to if MarshalText fails.
+type rawLevel int
+
+func (l rawLevel) MarshalText() ([]byte, error) {
+	return level(l).MarshalText()
+}
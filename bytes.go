@@ -0,0 +1,165 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Encoding describes how binary data is represented in strings.
+type Encoding int
+
+const (
+	// EncodingRaw uses the bytes of the string as is.
+	EncodingRaw Encoding = iota
+
+	// EncodingBase64 uses standard base64 encoding (as defined in RFC 4648).
+	// Padding is optional when decoding.
+	EncodingBase64
+
+	// EncodingBase64URL uses the alternate, URL safe base64 encoding (as defined in RFC 4648).
+	// Padding is optional when decoding.
+	EncodingBase64URL
+
+	// EncodingHex uses hexadecimal encoding.
+	EncodingHex
+
+	// EncodingAuto detects the encoding of strings with an unambiguous marker when decoding:
+	// strings with a "0x" prefix are decoded as hexadecimal,
+	// strings with base64 padding (a trailing "=") are decoded as standard or URL safe base64.
+	// Anything else (including strings that fail to decode) is used as raw bytes.
+	//
+	// Base64 strings without padding are not detected. Text ending with "=" (like "key=") may still be
+	// valid base64 and is decoded: use [EncodingRaw] (the default) for arbitrary text.
+	//
+	// When encoding, it behaves like [EncodingRaw].
+	EncodingAuto
+)
+
+// ToBytesE casts any value to a []byte type.
+//
+// Strings are converted to their raw bytes. Use [ToBytesWithE] and [WithEncoding] to decode encoded strings.
+func ToBytesE(i any) ([]byte, error) {
+	return toBytesE(i, config{})
+}
+
+// ToBytesWithE casts any value to a []byte type using the given options.
+//
+// Strings (and JSON strings in [json.RawMessage] values) are decoded using the encoding set by [WithEncoding].
+// Byte slices are returned as is, the elements of other slices are converted to bytes one by one.
+func ToBytesWithE(i any, opts ...Option) ([]byte, error) {
+	return toBytesE(i, newConfig(opts))
+}
+
+func toBytesE(i any, c config) ([]byte, error) {
+	i, _ = indirect(i)
+
+	switch v := i.(type) {
+	case []byte:
+		return v, nil
+	case json.RawMessage:
+		var val any
+		if err := json.Unmarshal(v, &val); err != nil {
+			return nil, fmt.Errorf(errorMsgWith, i, i, []byte{}, err)
+		}
+
+		return toBytesE(val, c)
+	case string:
+		b, err := decodeBytes(v, c.encoding)
+		if err != nil {
+			return nil, fmt.Errorf(errorMsgWith, i, i, []byte{}, err)
+		}
+
+		return b, nil
+	case nil:
+		return nil, nil
+	}
+
+	if v, ok := resolveAlias(i); ok {
+		if s, ok := v.(string); ok {
+			return toBytesE(s, c)
+		}
+	}
+
+	return toSliceEWith(i, toByteE)
+}
+
+// toByteE casts any value to a byte, rejecting values out of range.
+func toByteE(i any) (byte, error) {
+	v, err := ToInt64E(i)
+	if err != nil {
+		return 0, err
+	}
+
+	if v < 0 || v > 255 {
+		return 0, fmt.Errorf(errorMsg, i, i, byte(0))
+	}
+
+	return byte(v), nil
+}
+
+func decodeBytes(s string, enc Encoding) ([]byte, error) {
+	switch enc {
+	case EncodingBase64:
+		return decodeBase64(s, base64.StdEncoding, base64.RawStdEncoding)
+	case EncodingBase64URL:
+		return decodeBase64(s, base64.URLEncoding, base64.RawURLEncoding)
+	case EncodingHex:
+		return hex.DecodeString(s)
+	case EncodingAuto:
+		if h, ok := cutHexPrefix(s); ok {
+			if b, err := hex.DecodeString(h); err == nil {
+				return b, nil
+			}
+		}
+
+		if strings.HasSuffix(s, "=") {
+			for _, enc := range []*base64.Encoding{base64.StdEncoding, base64.URLEncoding} {
+				if b, err := enc.DecodeString(s); err == nil {
+					return b, nil
+				}
+			}
+		}
+
+		return []byte(s), nil
+	default:
+		return []byte(s), nil
+	}
+}
+
+// cutHexPrefix removes the "0x" (or "0X") prefix of s, reporting whether it was found.
+func cutHexPrefix(s string) (string, bool) {
+	if len(s) > 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		return s[2:], true
+	}
+
+	return s, false
+}
+
+func decodeBase64(s string, padded *base64.Encoding, raw *base64.Encoding) ([]byte, error) {
+	if strings.HasSuffix(s, "=") {
+		return padded.DecodeString(s)
+	}
+
+	return raw.DecodeString(s)
+}
+
+func encodeBytes(b []byte, enc Encoding) string {
+	switch enc {
+	case EncodingBase64:
+		return base64.StdEncoding.EncodeToString(b)
+	case EncodingBase64URL:
+		return base64.URLEncoding.EncodeToString(b)
+	case EncodingHex:
+		return hex.EncodeToString(b)
+	default:
+		return string(b)
+	}
+}
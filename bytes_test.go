@@ -0,0 +1,124 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"github.com/spf13/cast"
+)
+
+func TestBytes(t *testing.T) {
+	testCases := []testCase{
+		{[]byte("secret"), []byte("secret"), false},
+		{"secret", []byte("secret"), false},
+		{MyString("secret"), []byte("secret"), false},
+		{[]any{115, 101, 99, "114", 101.0, uint8(116)}, []byte("secret"), false},
+		{[]int{115, 101, 99}, []byte("sec"), false},
+		{json.RawMessage(`"secret"`), []byte("secret"), false},
+		{json.RawMessage(`[115, 101, 99]`), []byte("sec"), false},
+
+		// Failure cases
+		{[]any{256}, nil, true},
+		{[]any{-1}, nil, true},
+		{[]any{"test"}, nil, true},
+		{json.RawMessage(`{`), nil, true},
+		{testing.T{}, nil, true},
+	}
+
+	runSliceTests(t, testCases, cast.ToBytes, cast.ToBytesE)
+}
+
+func TestBytesWithEncoding(t *testing.T) {
+	secret := []byte{0xde, 0xad, 0xbe, 0xef, 0xfb, 0xff}
+
+	toBytesWith := func(enc cast.Encoding) func(i any) ([]byte, error) {
+		return func(i any) ([]byte, error) {
+			return cast.ToBytesWithE(i, cast.WithEncoding(enc))
+		}
+	}
+
+	t.Run("Base64", func(t *testing.T) {
+		testCases := []testCase{
+			{"3q2+7/v/", secret, false},
+			{"3q2+7w==", secret[:4], false},
+			{"3q2+7w", secret[:4], false},
+			{json.RawMessage(`"3q2+7/v/"`), secret, false},
+
+			// Failure cases
+			{"3q2-7_v_", nil, true},
+			{"not encoded!", nil, true},
+		}
+
+		runConversionTests(t, testCases, nil, toBytesWith(cast.EncodingBase64))
+	})
+
+	t.Run("Base64URL", func(t *testing.T) {
+		testCases := []testCase{
+			{"3q2-7_v_", secret, false},
+			{"3q2-7w", secret[:4], false},
+		}
+
+		runConversionTests(t, testCases, nil, toBytesWith(cast.EncodingBase64URL))
+	})
+
+	t.Run("Hex", func(t *testing.T) {
+		testCases := []testCase{
+			{"deadbeeffbff", secret, false},
+			{secret, secret, false},
+
+			// Failure cases
+			{"deadbeefx", nil, true},
+		}
+
+		runConversionTests(t, testCases, nil, toBytesWith(cast.EncodingHex))
+	})
+
+	t.Run("Auto", func(t *testing.T) {
+		testCases := []testCase{
+			{"0xdeadbeeffbff", secret, false},
+			{"0XDEADBEEFFBFF", secret, false},
+			{"3q2+7w==", secret[:4], false},
+			{"3q2-7w==", secret[:4], false},
+			{"not encoded!", []byte("not encoded!"), false},
+
+			// Text without a marker is not decoded
+			{"deadbeeffbff", []byte("deadbeeffbff"), false},
+			{"3q2+7/v/", []byte("3q2+7/v/"), false},
+			{"cafe", []byte("cafe"), false},
+			{"test", []byte("test"), false},
+			{"0xcoffee", []byte("0xcoffee"), false},
+			{"a=b", []byte("a=b"), false},
+		}
+
+		runConversionTests(t, testCases, nil, toBytesWith(cast.EncodingAuto))
+	})
+}
+
+func TestStringWithEncoding(t *testing.T) {
+	c := qt.New(t)
+
+	secret := []byte{0xde, 0xad, 0xbe, 0xef, 0xfb, 0xff}
+
+	for enc, expected := range map[cast.Encoding]string{
+		cast.EncodingRaw:       string(secret),
+		cast.EncodingAuto:      string(secret),
+		cast.EncodingBase64:    "3q2+7/v/",
+		cast.EncodingBase64URL: "3q2-7_v_",
+		cast.EncodingHex:       "deadbeeffbff",
+	} {
+		v, err := cast.ToStringWithE(secret, cast.WithEncoding(enc))
+		c.Assert(err, qt.IsNil)
+		c.Assert(v, qt.Equals, expected)
+
+		b, err := cast.ToBytesWithE(v, cast.WithEncoding(enc))
+		c.Assert(err, qt.IsNil)
+		c.Assert(b, qt.DeepEquals, secret)
+	}
+}
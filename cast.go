@@ -6,58 +6,106 @@
 // Package cast provides easy and safe casting in Go.
 package cast
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
 
 const errorMsg = "unable to cast %#v of type %T to %T"
 const errorMsgWith = "unable to cast %#v of type %T to %T: %w"
 
+// wrapErrors wraps the element errors of a compound value (if any) in a single error describing the failed conversion.
+func wrapErrors(i any, t any, errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf(errorMsgWith, i, i, t, errors.Join(errs...))
+}
+
 // Basic is a type parameter constraint for functions accepting basic types.
 //
-// It represents the supported basic types this package can cast to.
+// It represents the supported basic types this package can cast to,
+// including named types based on them (e.g. type LogLevel int or [time.Duration]).
+//
+// Named types (other than [time.Duration]) are converted using their underlying kind,
+// unless they implement [encoding.TextUnmarshaler] and the input is text.
+// For example, given type Timeout time.Duration, ToE[Timeout]("5") returns Timeout(5),
+// but ToE[Timeout]("5s") fails: the underlying type of Timeout is int64, not time.Duration.
 type Basic interface {
-	string | bool | Number | time.Time | time.Duration
+	~string | ~bool |
+		~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64 | ~complex64 | ~complex128 |
+		time.Time
 }
 
 // ToE casts any value to a [Basic] type.
 func ToE[T Basic](i any) (T, error) {
+	return toE[T](i, config{})
+}
+
+// ToWithE casts any value to a [Basic] type using the given options.
+func ToWithE[T Basic](i any, opts ...Option) (T, error) {
+	return toE[T](i, newConfig(opts))
+}
+
+func toE[T Basic](i any, c config) (T, error) {
 	var t T
 
 	var v any
 	var err error
 
+	// Like in AsE, strings (including named string types) are not normalized
+	if c.normalize && normalizes(reflect.TypeOf(t)) {
+		i = c.normalizeInput(i)
+	}
+
 	switch any(t).(type) {
 	case string:
-		v, err = ToStringE(i)
+		v, err = toStringE(i, c)
 	case bool:
 		v, err = ToBoolE(i)
 	case int:
-		v, err = toNumberE[int](i, parseInt[int])
+		v, err = toNumberE[int](i, parseInt[int], c)
 	case int8:
-		v, err = toNumberE[int8](i, parseInt[int8])
+		v, err = toNumberE[int8](i, parseInt[int8], c)
 	case int16:
-		v, err = toNumberE[int16](i, parseInt[int16])
+		v, err = toNumberE[int16](i, parseInt[int16], c)
 	case int32:
-		v, err = toNumberE[int32](i, parseInt[int32])
+		v, err = toNumberE[int32](i, parseInt[int32], c)
 	case int64:
-		v, err = toNumberE[int64](i, parseInt[int64])
+		v, err = toNumberE[int64](i, parseInt[int64], c)
 	case uint:
-		v, err = toUnsignedNumberE[uint](i, parseUint[uint])
+		v, err = toUnsignedNumberE[uint](i, parseUint[uint], c)
 	case uint8:
-		v, err = toUnsignedNumberE[uint8](i, parseUint[uint8])
+		v, err = toUnsignedNumberE[uint8](i, parseUint[uint8], c)
 	case uint16:
-		v, err = toUnsignedNumberE[uint16](i, parseUint[uint16])
+		v, err = toUnsignedNumberE[uint16](i, parseUint[uint16], c)
 	case uint32:
-		v, err = toUnsignedNumberE[uint32](i, parseUint[uint32])
+		v, err = toUnsignedNumberE[uint32](i, parseUint[uint32], c)
 	case uint64:
-		v, err = toUnsignedNumberE[uint64](i, parseUint[uint64])
+		v, err = toUnsignedNumberE[uint64](i, parseUint[uint64], c)
 	case float32:
-		v, err = toNumberE[float32](i, parseFloat[float32])
+		v, err = toNumberE[float32](i, parseFloat[float32], c)
 	case float64:
-		v, err = toNumberE[float64](i, parseFloat[float64])
+		v, err = toNumberE[float64](i, parseFloat[float64], c)
+	case complex64:
+		v, err = toComplexE[complex64](i, 64, c)
+	case complex128:
+		v, err = toComplexE[complex128](i, 128, c)
 	case time.Time:
 		v, err = ToTimeE(i)
 	case time.Duration:
 		v, err = ToDurationE(i)
+	default:
+		// Named types are converted via their underlying type
+		var r reflect.Value
+
+		r, err = convertValue(i, reflect.TypeOf(t), c)
+		v = r.Interface()
 	}
 
 	if err != nil {
@@ -67,6 +115,19 @@ func ToE[T Basic](i any) (T, error) {
 	return v.(T), nil
 }
 
+// toFunc returns a function casting values to T using the given options.
+func toFunc[T Basic](opts []Option) func(any) (T, error) {
+	if len(opts) == 0 {
+		return ToE[T]
+	}
+
+	c := newConfig(opts)
+
+	return func(i any) (T, error) {
+		return toE[T](i, c)
+	}
+}
+
 // Must is a helper that wraps a call to a cast function and panics if the error is non-nil.
 func Must[T any](i any, err error) T {
 	if err != nil {
@@ -6,7 +6,10 @@
 package cast_test
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
@@ -141,6 +144,138 @@ func runTests[T cast.Basic](t *testing.T, testCases []testCase, to func(i any) T
 	}
 }
 
+// runConversionTests runs table driven tests of a conversion function that is not covered by [runTests]
+// (e.g. because it accepts options or returns a non-basic type), comparing results using [assertDeepEqual].
+//
+// to is the variant of toErr without an error (if any) and may be nil.
+func runConversionTests[T any](t *testing.T, testCases []testCase, to func(i any) T, toErr func(i any) (T, error)) {
+	for _, testCase := range testCases {
+		// TODO: remove after minimum Go version is >=1.22
+		testCase := testCase
+
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			c := qt.New(t)
+
+			v, err := toErr(testCase.input)
+			if testCase.expectError {
+				c.Assert(err, qt.IsNotNil)
+			} else {
+				c.Assert(err, qt.IsNil)
+				assertDeepEqual(c, v, testCase.expected)
+			}
+
+			if to != nil {
+				assertDeepEqual(c, to(testCase.input), v)
+			}
+		})
+	}
+}
+
+// assertDeepEqual compares values using [reflect.DeepEqual]: unlike [qt.DeepEquals], it supports unexported fields.
+func assertDeepEqual(c *qt.C, got, want any) {
+	c.Helper()
+
+	c.Assert(reflect.DeepEqual(got, want), qt.IsTrue, qt.Commentf("got %#v, want %#v", got, want))
+}
+
+func TestNamedTypes(t *testing.T) {
+	t.Run("String", func(t *testing.T) {
+		testCases := []testCase{
+			{"prod", MyString("prod"), false},
+			{MyString("prod"), MyString("prod"), false},
+			{8, MyString("8"), false},
+			{testing.T{}, MyString(""), true},
+		}
+
+		runTests(t, testCases, cast.To[MyString], cast.ToE[MyString])
+	})
+
+	t.Run("Bool", func(t *testing.T) {
+		testCases := []testCase{
+			{"true", MyBool(true), false},
+			{0, MyBool(false), false},
+			{"foo", MyBool(false), true},
+		}
+
+		runTests(t, testCases, cast.To[MyBool], cast.ToE[MyBool])
+	})
+
+	t.Run("Int", func(t *testing.T) {
+		testCases := []testCase{
+			{"3", MyInt(3), false},
+			{3.5, MyInt(3), false},
+			{MyInt8(3), MyInt(3), false},
+			{json.Number("3"), MyInt(3), false},
+			{"foo", MyInt(0), true},
+		}
+
+		runTests(t, testCases, cast.To[MyInt], cast.ToE[MyInt])
+	})
+
+	t.Run("Uint16", func(t *testing.T) {
+		testCases := []testCase{
+			{"8080", MyUint16(8080), false},
+			{-1, MyUint16(0), true},
+		}
+
+		runTests(t, testCases, cast.To[MyUint16], cast.ToE[MyUint16])
+	})
+
+	t.Run("Float64", func(t *testing.T) {
+		testCases := []testCase{
+			{"8.31", MyFloat64(8.31), false},
+			{MyInt(8), MyFloat64(8), false},
+			{"foo", MyFloat64(0), true},
+		}
+
+		runTests(t, testCases, cast.To[MyFloat64], cast.ToE[MyFloat64])
+	})
+
+	t.Run("Duration", func(t *testing.T) {
+		type timeout time.Duration
+
+		testCases := []testCase{
+			{"5", timeout(5), false},
+			{5 * time.Second, timeout(5 * time.Second), false},
+
+			// The underlying type is int64, not time.Duration
+			{"5s", timeout(0), true},
+		}
+
+		runTests(t, testCases, cast.To[timeout], cast.ToE[timeout])
+	})
+
+	t.Run("TextUnmarshaler", func(t *testing.T) {
+		testCases := []testCase{
+			{"warning", level(2), false},
+			{2, level(2), false},
+			{"unknown", level(0), true},
+		}
+
+		runTests(t, testCases, cast.To[level], cast.ToE[level])
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		c := qt.New(t)
+
+		v, err := cast.ToWithE[MyInt](" 3 ", cast.WithNormalization())
+		c.Assert(err, qt.IsNil)
+		c.Assert(v, qt.Equals, MyInt(3))
+
+		s, err := cast.ToSliceOfE[MyString]([]any{"a", 1})
+		c.Assert(err, qt.IsNil)
+		c.Assert(s, qt.DeepEquals, []MyString{"a", "1"})
+
+		m, err := cast.ToMapE[MyString, MyInt](map[string]any{"a": "1"})
+		c.Assert(err, qt.IsNil)
+		c.Assert(m, qt.DeepEquals, map[MyString]MyInt{"a": 1})
+
+		c.Assert(cast.ToOr[MyInt]("foo", 42), qt.Equals, MyInt(42))
+	})
+}
+
 func Example() {
 	// Cast a value to another type
 	{
@@ -239,3 +374,10 @@ type MyUint32 uint32
 type MyUint64 uint64
 type MyFloat32 float32
 type MyFloat64 float64
+
+// MyStatus is a named integer stored by its name in databases.
+type MyStatus int
+
+func (s MyStatus) Value() (driver.Value, error) {
+	return fmt.Sprintf("status-%d", int(s)), nil
+}
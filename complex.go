@@ -0,0 +1,108 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ToComplex128E casts any value to a complex128 type.
+func ToComplex128E(i any) (complex128, error) {
+	return toComplexE[complex128](i, 128, config{})
+}
+
+// ToComplex64E casts any value to a complex64 type.
+func ToComplex64E(i any) (complex64, error) {
+	return toComplexE[complex64](i, 64, config{})
+}
+
+func toComplexE[T complex64 | complex128](i any, bitSize int, c config) (T, error) {
+	i, _ = indirect(i)
+
+	var t T
+
+	switch s := i.(type) {
+	case complex128:
+		return checkFiniteComplex[T](i, s, c)
+	case complex64:
+		return checkFiniteComplex[T](i, complex128(s), c)
+	case string:
+		return parseComplex[T](i, s, bitSize, c)
+	case json.Number:
+		return parseComplex[T](i, string(s), bitSize, c)
+	case nil:
+		return 0, nil
+	default:
+		if i, ok := resolveAlias(i); ok {
+			return toComplexE[T](i, bitSize, c)
+		}
+
+		if i, ok := indirectValuer(i); ok {
+			return toComplexE[T](i, bitSize, c)
+		}
+
+		// Fall back to real numbers
+		v, err := toNumberE[float64](i, parseFloat[float64], c)
+		if err != nil {
+			return 0, fmt.Errorf(errorMsgWith, i, i, t, err)
+		}
+
+		return T(complex(v, 0)), nil
+	}
+}
+
+func parseComplex[T complex64 | complex128](i any, s string, bitSize int, c config) (T, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	if c.strictNumbers {
+		for _, part := range complexParts(s) {
+			if err := checkStrictNumber[float64](part); err != nil {
+				return 0, fmt.Errorf(errorMsgWith, i, i, T(0), err)
+			}
+		}
+	}
+
+	v, err := strconv.ParseComplex(s, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf(errorMsgWith, i, i, T(0), err)
+	}
+
+	return checkFiniteComplex[T](i, v, c)
+}
+
+// checkFiniteComplex makes sure both parts of v are finite, if required (see [WithFiniteFloats]).
+func checkFiniteComplex[T complex64 | complex128](i any, v complex128, c config) (T, error) {
+	if c.finiteFloats {
+		for _, f := range []float64{real(v), imag(v)} {
+			if math.IsNaN(f) || math.IsInf(f, 0) {
+				return 0, fmt.Errorf(errorMsgWith, i, i, T(0), errNonFinite)
+			}
+		}
+	}
+
+	return T(v), nil
+}
+
+// complexParts splits a complex number string (like "(1+2i)") into its real and imaginary parts.
+func complexParts(s string) []string {
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "("), ")")
+	s = strings.TrimSuffix(s, "i")
+
+	// The imaginary part starts with a sign that is not part of an exponent
+	for j := 1; j < len(s); j++ {
+		if (s[j] == '+' || s[j] == '-') && strings.IndexByte("eEpP", s[j-1]) < 0 {
+			return []string{s[:j], s[j:]}
+		}
+	}
+
+	return []string{s}
+}
@@ -0,0 +1,60 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/spf13/cast"
+)
+
+type MyComplex128 complex128
+
+func TestComplex128(t *testing.T) {
+	var ptr *complex128
+
+	testCases := []testCase{
+		{complex(1, 2), complex(1, 2), false},
+		{complex64(complex(1, 2)), complex(1, 2), false},
+		{"1+2i", complex(1, 2), false},
+		{"(1+2i)", complex(1, 2), false},
+		{"-2i", complex(0, -2), false},
+		{"8.31", complex(8.31, 0), false},
+		{json.Number("8"), complex(8, 0), false},
+		{8, complex(8, 0), false},
+		{uint8(8), complex(8, 0), false},
+		{8.31, complex(8.31, 0), false},
+		{true, complex(1, 0), false},
+		{MyComplex128(complex(1, 2)), complex(1, 2), false},
+		{MyString("1+2i"), complex(1, 2), false},
+		{"", complex128(0), false},
+		{nil, complex128(0), false},
+		{ptr, complex128(0), false},
+
+		// Failure cases
+		{"test", complex128(0), true},
+		{"1+2j", complex128(0), true},
+		{testing.T{}, complex128(0), true},
+	}
+
+	runTests(t, testCases, cast.ToComplex128, cast.ToComplex128E)
+}
+
+func TestComplex64(t *testing.T) {
+	testCases := []testCase{
+		{complex(1, 2), complex64(complex(1, 2)), false},
+		{"1.5+2.5i", complex64(complex(1.5, 2.5)), false},
+		{8, complex64(complex(8, 0)), false},
+		{nil, complex64(0), false},
+
+		// Failure cases
+		{"test", complex64(0), true},
+		{testing.T{}, complex64(0), true},
+	}
+
+	runTests(t, testCases, cast.ToComplex64, cast.ToComplex64E)
+}
@@ -0,0 +1,72 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast
+
+// ToOr casts any value to a [Basic] type, returning def if the conversion fails.
+func ToOr[T Basic](i any, def T, opts ...Option) T {
+	return ToOrElse(i, func() T { return def }, opts...)
+}
+
+// ToOrElse casts any value to a [Basic] type, returning the result of fn if the conversion fails.
+//
+// fn is only called when the default value is needed.
+func ToOrElse[T Basic](i any, fn func() T, opts ...Option) T {
+	return toOrElse(i, fn, toFunc[T](opts), opts)
+}
+
+// ToSliceOr casts any value to a []T type (see [ToSliceOfE]), returning def if the conversion fails.
+func ToSliceOr[T Basic](i any, def []T, opts ...Option) []T {
+	return ToSliceOrElse(i, func() []T { return def }, opts...)
+}
+
+// ToSliceOrElse casts any value to a []T type (see [ToSliceOfE]), returning the result of fn if the conversion fails.
+//
+// fn is only called when the default value is needed.
+func ToSliceOrElse[T Basic](i any, fn func() []T, opts ...Option) []T {
+	toE := func(i any) ([]T, error) { return ToSliceOfE[T](i, opts...) }
+
+	return toOrElse(i, fn, toE, opts)
+}
+
+// ToMapOr casts any value to a map[K]V type (see [ToMapE]), returning def if the conversion fails.
+func ToMapOr[K, V Basic](i any, def map[K]V, opts ...Option) map[K]V {
+	return ToMapOrElse(i, func() map[K]V { return def }, opts...)
+}
+
+// ToMapOrElse casts any value to a map[K]V type (see [ToMapE]), returning the result of fn if the conversion fails.
+//
+// fn is only called when the default value is needed.
+func ToMapOrElse[K, V Basic](i any, fn func() map[K]V, opts ...Option) map[K]V {
+	toE := func(i any) (map[K]V, error) { return ToMapE[K, V](i, opts...) }
+
+	return toOrElse(i, fn, toE, opts)
+}
+
+func toOrElse[T any](i any, fn func() T, toE func(any) (T, error), opts []Option) T {
+	c := newConfig(opts)
+
+	if c.defaultOnNil && isNil(i) {
+		return fn()
+	}
+
+	v, err := toE(i)
+	if err != nil {
+		return fn()
+	}
+
+	return v
+}
+
+// isNil reports whether a value is nil after indirection and unwrapping [database/sql/driver.Valuer] values.
+func isNil(i any) bool {
+	i, _ = indirect(i)
+
+	if v, ok := indirectValuer(i); ok {
+		i = v
+	}
+
+	return i == nil
+}
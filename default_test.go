@@ -0,0 +1,77 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+
+	"github.com/spf13/cast"
+)
+
+func TestOr(t *testing.T) {
+	c := qt.New(t)
+
+	var ptr *int
+
+	c.Assert(cast.ToOr("8", 8080), qt.Equals, 8)
+	c.Assert(cast.ToOr(MyInt(8), 8080), qt.Equals, 8)
+	c.Assert(cast.ToOr("test", 8080), qt.Equals, 8080)
+	c.Assert(cast.ToOr("5s", time.Minute), qt.Equals, 5*time.Second)
+	c.Assert(cast.ToOr("test", time.Minute), qt.Equals, time.Minute)
+
+	// Nil inputs
+	c.Assert(cast.ToOr(nil, 8080), qt.Equals, 0)
+	c.Assert(cast.ToOr(nil, 8080, cast.WithDefaultOnNil()), qt.Equals, 8080)
+	c.Assert(cast.ToOr(ptr, 8080, cast.WithDefaultOnNil()), qt.Equals, 8080)
+	c.Assert(cast.ToOr(sql.NullInt64{}, 8080, cast.WithDefaultOnNil()), qt.Equals, 8080)
+	c.Assert(cast.ToOr(0, 8080, cast.WithDefaultOnNil()), qt.Equals, 0)
+
+	// Options are applied to the conversion
+	c.Assert(cast.ToOr(" 8 ", 8080, cast.WithNormalization()), qt.Equals, 8)
+	c.Assert(cast.ToOr("0644", 8080, cast.WithStrictNumbers()), qt.Equals, 8080)
+}
+
+func TestOrElse(t *testing.T) {
+	c := qt.New(t)
+
+	var calls int
+
+	fn := func() string {
+		calls++
+
+		return "default"
+	}
+
+	c.Assert(cast.ToOrElse(8, fn), qt.Equals, "8")
+	c.Assert(calls, qt.Equals, 0)
+
+	c.Assert(cast.ToOrElse(testing.T{}, fn), qt.Equals, "default")
+	c.Assert(calls, qt.Equals, 1)
+
+	c.Assert(cast.ToOrElse(nil, fn, cast.WithDefaultOnNil()), qt.Equals, "default")
+	c.Assert(calls, qt.Equals, 2)
+}
+
+func TestSliceOr(t *testing.T) {
+	c := qt.New(t)
+
+	c.Assert(cast.ToSliceOr([]string{"1", "2"}, []int{8080}), qt.DeepEquals, []int{1, 2})
+	c.Assert(cast.ToSliceOr([]string{"1", "test"}, []int{8080}), qt.DeepEquals, []int{8080})
+	c.Assert(cast.ToSliceOr(nil, []int{8080}), qt.DeepEquals, []int{8080})
+	c.Assert(cast.ToSliceOrElse(nil, func() []int { return []int{8080} }), qt.DeepEquals, []int{8080})
+}
+
+func TestMapOr(t *testing.T) {
+	c := qt.New(t)
+
+	c.Assert(cast.ToMapOr(map[string]any{"a": "1"}, map[string]int{"b": 2}), qt.DeepEquals, map[string]int{"a": 1})
+	c.Assert(cast.ToMapOr(map[string]any{"a": "test"}, map[string]int{"b": 2}), qt.DeepEquals, map[string]int{"b": 2})
+	c.Assert(cast.ToMapOrElse(nil, func() map[string]int { return map[string]int{"b": 2} }), qt.DeepEquals, map[string]int{"b": 2})
+}
@@ -0,0 +1,151 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// enum holds the names registered for an enum type.
+type enum struct {
+	// names maps lower case names to values.
+	names map[string]any
+
+	// values maps values to their (first) name.
+	values map[any]string
+
+	// choices lists the valid names (ordered by value) for error messages.
+	choices string
+}
+
+var (
+	enums    sync.Map // reflect.Type -> *enum
+	hasEnums atomic.Bool
+)
+
+// RegisterEnum registers the names of the values of the enum type T.
+//
+// Conversions to T (using [ToE], [AsE] or [Into]) accept the registered names (case-insensitively)
+// as well as integral numbers matching a registered value. Empty input (nil or an empty string) is only accepted
+// if the zero value has a name. Anything else results in an error listing the valid names.
+// String conversions render registered values using their name.
+//
+// If multiple names are registered for the same value, the first one in lexical order is used for rendering.
+// Registering the names of a type again replaces the previous registration.
+func RegisterEnum[T ~int](names map[string]T) {
+	e := &enum{
+		names:  make(map[string]any, len(names)),
+		values: make(map[any]string, len(names)),
+	}
+
+	keys := make([]string, 0, len(names))
+	for name := range names {
+		keys = append(keys, name)
+	}
+
+	slices.Sort(keys)
+
+	for _, name := range keys {
+		v := names[name]
+
+		e.names[strings.ToLower(name)] = v
+
+		if _, ok := e.values[v]; !ok {
+			e.values[v] = name
+		}
+	}
+
+	slices.SortStableFunc(keys, func(a, b string) int {
+		return cmp.Compare(names[a], names[b])
+	})
+
+	e.choices = strings.Join(keys, ", ")
+
+	var t T
+
+	enums.Store(reflect.TypeOf(t), e)
+	hasEnums.Store(true)
+}
+
+// lookupEnum returns the names registered for type t (if any).
+func lookupEnum(t reflect.Type) (*enum, bool) {
+	if !hasEnums.Load() || t == nil {
+		return nil, false
+	}
+
+	e, ok := enums.Load(t)
+	if !ok {
+		return nil, false
+	}
+
+	return e.(*enum), true
+}
+
+// enumName returns the registered name of i (if any).
+func enumName(i any) (string, bool) {
+	e, ok := lookupEnum(reflect.TypeOf(i))
+	if !ok {
+		return "", false
+	}
+
+	name, ok := e.values[i]
+
+	return name, ok
+}
+
+// convert converts i to the enum type t.
+func (e *enum) convert(i any, t reflect.Type, c config) (reflect.Value, error) {
+	i, _ = indirect(i)
+
+	if v, ok := indirectValuer(i); ok {
+		i = v
+	}
+
+	zero := reflect.Zero(t)
+
+	// Empty input is only accepted if the zero value has a name
+	empty := i == nil
+
+	if isText(i) {
+		if s, err := toStringE(i, c); err == nil {
+			s = strings.TrimSpace(s)
+
+			if v, ok := e.names[strings.ToLower(s)]; ok {
+				return reflect.ValueOf(v), nil
+			}
+
+			empty = s == ""
+		}
+	}
+
+	if empty {
+		if _, ok := e.values[zero.Interface()]; ok {
+			return zero, nil
+		}
+
+		return zero, fmt.Errorf(errorMsgWith, i, i, zero.Interface(), fmt.Errorf("empty value (valid values: %s)", e.choices))
+	}
+
+	// Numbers (including numeric strings) matching a registered value, rejecting fractions instead of truncating them
+	if f, err := toE[float64](i, c); err != nil || f == math.Trunc(f) {
+		if n, err := toE[int](i, c); err == nil {
+			v := reflect.ValueOf(n).Convert(t)
+
+			if _, ok := e.values[v.Interface()]; ok {
+				return v, nil
+			}
+		}
+	}
+
+	return zero, fmt.Errorf(errorMsgWith, i, i, zero.Interface(), fmt.Errorf("unknown value (valid values: %s)", e.choices))
+}
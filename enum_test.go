@@ -0,0 +1,139 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast_test
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"github.com/spf13/cast"
+)
+
+type logLevel int
+
+const (
+	logLevelDebug logLevel = iota
+	logLevelInfo
+	logLevelWarning
+	logLevelError
+)
+
+// color has no name for its zero value.
+type color int
+
+func init() {
+	cast.RegisterEnum(map[string]color{
+		"red":   1,
+		"green": 2,
+		"blue":  3,
+	})
+
+	cast.RegisterEnum(map[string]logLevel{
+		"debug":   logLevelDebug,
+		"info":    logLevelInfo,
+		"warning": logLevelWarning,
+		"warn":    logLevelWarning,
+		"error":   logLevelError,
+	})
+}
+
+func TestEnum(t *testing.T) {
+	testCases := []testCase{
+		{"warning", logLevelWarning, false},
+		{"WARNING", logLevelWarning, false},
+		{"Warn", logLevelWarning, false},
+		{" info ", logLevelInfo, false},
+		{[]byte("error"), logLevelError, false},
+		{MyString("debug"), logLevelDebug, false},
+		{"3", logLevelError, false},
+		{"0x3", logLevelError, false},
+		{2.0, logLevelWarning, false},
+		{3, logLevelError, false},
+		{int8(1), logLevelInfo, false},
+		{logLevelInfo, logLevelInfo, false},
+		{nil, logLevelDebug, false},
+		{"", logLevelDebug, false},
+
+		// Failure cases
+		{"bogus", logLevelDebug, true},
+		{"7", logLevelDebug, true},
+		{7, logLevelDebug, true},
+		{2.7, logLevelDebug, true},
+		{"2.7", logLevelDebug, true},
+		{float32(-0.5), logLevelDebug, true},
+		{logLevel(-1), logLevelDebug, true},
+		{testing.T{}, logLevelDebug, true},
+	}
+
+	runTests(t, testCases, cast.To[logLevel], cast.ToE[logLevel])
+}
+
+func TestEnumWithoutZero(t *testing.T) {
+	testCases := []testCase{
+		{"green", color(2), false},
+		{2, color(2), false},
+
+		// Failure cases
+		{nil, color(0), true},
+		{"", color(0), true},
+		{" ", color(0), true},
+		{0, color(0), true},
+		{1.5, color(0), true},
+	}
+
+	runTests(t, testCases, cast.To[color], cast.ToE[color])
+}
+
+func TestEnumErrors(t *testing.T) {
+	c := qt.New(t)
+
+	_, err := cast.ToE[logLevel]("bogus")
+	c.Assert(err, qt.ErrorMatches, `.*unknown value \(valid values: debug, info, warn, warning, error\)`)
+
+	_, err = cast.ToSliceOfE[logLevel]([]string{"info", "bogus"})
+	c.Assert(err, qt.IsNotNil)
+}
+
+func TestEnumString(t *testing.T) {
+	c := qt.New(t)
+
+	c.Assert(cast.ToString(logLevelWarning), qt.Equals, "warn")
+	c.Assert(cast.ToString(logLevelError), qt.Equals, "error")
+
+	v := logLevelInfo
+	c.Assert(cast.ToString(&v), qt.Equals, "info")
+
+	// Values without a name are rendered as numbers
+	c.Assert(cast.ToString(logLevel(7)), qt.Equals, "7")
+
+	s, err := cast.ToStringWithE(logLevelDebug, cast.WithStrictString())
+	c.Assert(err, qt.IsNil)
+	c.Assert(s, qt.Equals, "debug")
+
+	c.Assert(cast.ToStringSlice([]logLevel{logLevelDebug, logLevelError}), qt.DeepEquals, []string{"debug", "error"})
+}
+
+func TestEnumComposite(t *testing.T) {
+	c := qt.New(t)
+
+	m, err := cast.AsE[map[string]logLevel](map[string]any{"api": "Debug", "db": 3})
+	c.Assert(err, qt.IsNil)
+	c.Assert(m, qt.DeepEquals, map[string]logLevel{"api": logLevelDebug, "db": logLevelError})
+
+	var config struct {
+		Level *logLevel
+	}
+
+	c.Assert(cast.Into(&config.Level, "warning"), qt.IsNil)
+	c.Assert(*config.Level, qt.Equals, logLevelWarning)
+
+	c.Assert(cast.Into(&config.Level, "bogus"), qt.IsNotNil)
+	c.Assert(*config.Level, qt.Equals, logLevelWarning)
+
+	// Registration is per type
+	c.Assert(cast.To[MyInt]("warning"), qt.Equals, MyInt(0))
+}
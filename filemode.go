@@ -0,0 +1,236 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var errInvalidSymbolicMode = errors.New("invalid symbolic file mode")
+
+// ToFileModeE casts any value to a [fs.FileMode] type.
+//
+// Strings are either octal numbers (with an optional "0" or "0o" prefix, e.g. "0644" or "755"),
+// symbolic modes as accepted by chmod (e.g. "u=rw,g=r,o=r" or "a+x")
+// or modes formatted by [fs.FileMode.String] (e.g. "-rw-r--r--" or "drwxr-xr-x").
+// Symbolic modes are applied to an empty mode.
+//
+// Integers (and [json.Number] values) are used as is, so the decimal 420 is equivalent to "0644".
+func ToFileModeE(i any) (fs.FileMode, error) {
+	i, _ = indirect(i)
+
+	switch v := i.(type) {
+	case fs.FileMode:
+		return v, nil
+	case string:
+		m, err := parseFileMode(v)
+		if err != nil {
+			return 0, fmt.Errorf(errorMsgWith, i, i, fs.FileMode(0), err)
+		}
+
+		return m, nil
+	case json.Number:
+		return integerToFileMode(i, v)
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return integerToFileMode(i, v)
+	case nil:
+		return 0, nil
+	default:
+		if i, ok := resolveAlias(i); ok {
+			return ToFileModeE(i)
+		}
+
+		if i, ok := indirectValuer(i); ok {
+			return ToFileModeE(i)
+		}
+
+		return 0, fmt.Errorf(errorMsg, i, i, fs.FileMode(0))
+	}
+}
+
+func integerToFileMode(i any, v any) (fs.FileMode, error) {
+	n, err := ToInt64E(v)
+	if err != nil {
+		return 0, fmt.Errorf(errorMsgWith, i, i, fs.FileMode(0), err)
+	}
+
+	if n < 0 || n > math.MaxUint32 {
+		return 0, fmt.Errorf(errorMsg, i, i, fs.FileMode(0))
+	}
+
+	return fs.FileMode(n), nil
+}
+
+func parseFileMode(s string) (fs.FileMode, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	if m, ok := parseListFileMode(s); ok {
+		return m, nil
+	}
+
+	if strings.ContainsAny(s, "=+-") {
+		return parseSymbolicFileMode(s)
+	}
+
+	if len(s) > 1 && s[0] == '0' && (s[1] == 'o' || s[1] == 'O') {
+		s = s[2:]
+	}
+
+	v, err := strconv.ParseUint(s, 8, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	return fs.FileMode(v), nil
+}
+
+// fileModeTypeChars are the characters used by [fs.FileMode.String] for the type and special bits (in bit order).
+const fileModeTypeChars = "dalTLDpSugct?"
+
+// parseListFileMode parses the format of [fs.FileMode.String] and ls -l (e.g. "-rw-r--r--" or "drwxr-xr-x").
+func parseListFileMode(s string) (fs.FileMode, bool) {
+	if len(s) < 10 {
+		return 0, false
+	}
+
+	var mode fs.FileMode
+
+	types, perms := s[:len(s)-9], s[len(s)-9:]
+
+	if types != "-" {
+		for j := 0; j < len(types); j++ {
+			k := strings.IndexByte(fileModeTypeChars, types[j])
+			if k < 0 {
+				return 0, false
+			}
+
+			bit := fs.FileMode(1) << (32 - 1 - k)
+			if mode&bit != 0 {
+				return 0, false
+			}
+
+			mode |= bit
+		}
+	}
+
+	const rwx = "rwxrwxrwx"
+
+	for j := 0; j < len(perms); j++ {
+		switch perms[j] {
+		case rwx[j]:
+			mode |= 1 << (8 - j)
+		case '-':
+		default:
+			return 0, false
+		}
+	}
+
+	return mode, true
+}
+
+// parseSymbolicFileMode parses a comma separated list of chmod style clauses (e.g. "u=rw,g=r,o=r").
+func parseSymbolicFileMode(s string) (fs.FileMode, error) {
+	var mode fs.FileMode
+
+	for _, clause := range strings.Split(s, ",") {
+		var who []byte
+
+		j := 0
+		for ; j < len(clause) && strings.IndexByte("ugoa", clause[j]) >= 0; j++ {
+			who = append(who, clause[j])
+		}
+
+		if len(who) == 0 {
+			who = []byte{'a'}
+		}
+
+		if j == len(clause) {
+			return 0, errInvalidSymbolicMode
+		}
+
+		for j < len(clause) {
+			op := clause[j]
+			if strings.IndexByte("=+-", op) < 0 {
+				return 0, errInvalidSymbolicMode
+			}
+
+			j++
+
+			var perms, mask fs.FileMode
+
+			for _, w := range who {
+				mask |= symbolicModeMask(w)
+			}
+
+			for ; j < len(clause) && strings.IndexByte("=+-", clause[j]) < 0; j++ {
+				p, ok := symbolicModePerm(clause[j])
+				if !ok {
+					return 0, errInvalidSymbolicMode
+				}
+
+				perms |= p
+			}
+
+			// Only "=" may be used without permissions (e.g. "o=" clears all bits of others)
+			if perms == 0 && op != '=' {
+				return 0, errInvalidSymbolicMode
+			}
+
+			perms &= mask
+
+			switch op {
+			case '+':
+				mode |= perms
+			case '-':
+				mode &^= perms
+			case '=':
+				mode = mode&^mask | perms
+			}
+		}
+	}
+
+	return mode, nil
+}
+
+// symbolicModeMask returns the bits a "who" character of a symbolic mode applies to.
+func symbolicModeMask(who byte) fs.FileMode {
+	switch who {
+	case 'u':
+		return 0o700 | fs.ModeSetuid
+	case 'g':
+		return 0o070 | fs.ModeSetgid
+	case 'o':
+		return 0o007 | fs.ModeSticky
+	default: // 'a'
+		return 0o777 | fs.ModeSetuid | fs.ModeSetgid | fs.ModeSticky
+	}
+}
+
+// symbolicModePerm returns the bits of a permission character of a symbolic mode for every class.
+func symbolicModePerm(perm byte) (fs.FileMode, bool) {
+	switch perm {
+	case 'r':
+		return 0o444, true
+	case 'w':
+		return 0o222, true
+	case 'x':
+		return 0o111, true
+	case 's':
+		return fs.ModeSetuid | fs.ModeSetgid, true
+	case 't':
+		return fs.ModeSticky, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast_test
+
+import (
+	"encoding/json"
+	"io/fs"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"github.com/spf13/cast"
+)
+
+func TestFileMode(t *testing.T) {
+	testCases := []testCase{
+		{fs.FileMode(0o644), fs.FileMode(0o644), false},
+		{fs.ModeDir | 0o755, fs.ModeDir | 0o755, false},
+		{"0644", fs.FileMode(0o644), false},
+		{"644", fs.FileMode(0o644), false},
+		{"0o755", fs.FileMode(0o755), false},
+		{"0", fs.FileMode(0), false},
+		{"", fs.FileMode(0), false},
+		{MyString("0600"), fs.FileMode(0o600), false},
+		{420, fs.FileMode(0o644), false},
+		{uint16(0o755), fs.FileMode(0o755), false},
+		{json.Number("420"), fs.FileMode(0o644), false},
+		{nil, fs.FileMode(0), false},
+
+		// Symbolic notation
+		{"u=rw,g=r,o=r", fs.FileMode(0o644), false},
+		{"u=rwx,go=rx", fs.FileMode(0o755), false},
+		{"a=r,u+w", fs.FileMode(0o644), false},
+		{"+x", fs.FileMode(0o111), false},
+		{"a=rwx,o-w", fs.FileMode(0o775), false},
+		{"u=rw-w", fs.FileMode(0o400), false},
+		{"u=rwxs,g=rxs,o=", fs.ModeSetuid | fs.ModeSetgid | 0o750, false},
+		{"a=rwxt", fs.ModeSticky | 0o777, false},
+		{"ug=rw,o=", fs.FileMode(0o660), false},
+
+		// fs.FileMode.String notation
+		{"-rw-r--r--", fs.FileMode(0o644), false},
+		{"----------", fs.FileMode(0), false},
+		{"drwxr-xr-x", fs.ModeDir | 0o755, false},
+		{"Lrwxrwxrwx", fs.ModeSymlink | 0o777, false},
+		{"dtrwxrwxrwx", fs.ModeDir | fs.ModeSticky | 0o777, false},
+
+		// Failure cases
+		{"0800", fs.FileMode(0), true},
+		{"rw-r--r--", fs.FileMode(0), true},
+		{"u=rwz", fs.FileMode(0), true},
+		{"u", fs.FileMode(0), true},
+		{"u=r,", fs.FileMode(0), true},
+		{"u-", fs.FileMode(0), true},
+		{"a+", fs.FileMode(0), true},
+		{"u=r-", fs.FileMode(0), true},
+		{"-rw-r--r-x-", fs.FileMode(0), true},
+		{"ddrwxr-xr-x", fs.FileMode(0), true},
+		{"-rw-r--r-", fs.FileMode(0), true},
+		{"-1", fs.FileMode(0), true},
+		{-1, fs.FileMode(0), true},
+		{int64(1 << 33), fs.FileMode(0), true},
+		{8.31, fs.FileMode(0), true},
+		{testing.T{}, fs.FileMode(0), true},
+	}
+
+	runConversionTests(t, testCases, cast.ToFileMode, cast.ToFileModeE)
+}
+
+func TestFileModeString(t *testing.T) {
+	c := qt.New(t)
+
+	modes := []fs.FileMode{
+		0, 0o644, 0o755, 0o777,
+		fs.ModeDir | 0o700,
+		fs.ModeSetuid | 0o755,
+		fs.ModeSymlink | 0o777,
+		fs.ModeDevice | fs.ModeCharDevice | 0o620,
+		fs.ModeNamedPipe | fs.ModeSticky | fs.ModeSetgid | 0o600,
+	}
+
+	// Strings use the format of fs.FileMode.String (and ls -l), which can be parsed back
+	for _, mode := range modes {
+		s, err := cast.ToStringE(mode)
+		c.Assert(err, qt.IsNil)
+		c.Assert(s, qt.Equals, mode.String())
+
+		v, err := cast.ToFileModeE(s)
+		c.Assert(err, qt.IsNil, qt.Commentf(s))
+		c.Assert(v, qt.Equals, mode, qt.Commentf(s))
+	}
+
+	c.Assert(cast.ToString(fs.FileMode(0o644)), qt.Equals, "-rw-r--r--")
+	c.Assert(cast.ToString(fs.ModeDir|0o755), qt.Equals, "drwxr-xr-x")
+}
@@ -30,12 +30,25 @@ var toFuncs = []struct {
 	{"ToUint64", Uint64()},
 	{"ToFloat32", Float32()},
 	{"ToFloat64", Float64()},
+	{"ToComplex64", Complex64()},
+	{"ToComplex128", Complex128()},
+	{"ToRune", Rune()},
+	{"ToBytes", Index().Byte()},
+	{"ToIP", Qual("net", "IP")},
+	{"ToAddr", Qual("net/netip", "Addr")},
+	{"ToPrefix", Qual("net/netip", "Prefix")},
+	{"ToAddrPort", Qual("net/netip", "AddrPort")},
+	{"ToURL", Op("*").Qual("net/url", "URL")},
+	{"ToRegexp", Op("*").Qual("regexp", "Regexp")},
+	{"ToGlob", Op("*").Qual("regexp", "Regexp")},
+	{"ToFileMode", Qual("io/fs", "FileMode")},
 	{"ToStringMapString", Map(String()).String()},
 	{"ToStringMapStringSlice", Map(String()).Index().String()},
 	{"ToStringMapBool", Map(String()).Bool()},
 	{"ToStringMapInt", Map(String()).Int()},
 	{"ToStringMapInt64", Map(String()).Int64()},
 	{"ToStringMap", Map(String()).Any()},
+	{"ToOrderedStringMap", Index().Id("KeyValue").Types(String(), Any())},
 	{"ToSlice", Index().Any()},
 	{"ToBoolSlice", Index().Bool()},
 	{"ToStringSlice", Index().String()},
@@ -44,6 +57,14 @@ var toFuncs = []struct {
 	{"ToUintSlice", Index().Uint()},
 	{"ToFloat64Slice", Index().Float64()},
 	{"ToDurationSlice", Index().Qual("time", "Duration")},
+	{"ToRuneSlice", Index().Rune()},
+	{"ToIPSlice", Index().Qual("net", "IP")},
+	{"ToAddrSlice", Index().Qual("net/netip", "Addr")},
+	{"ToPrefixSlice", Index().Qual("net/netip", "Prefix")},
+	{"ToAddrPortSlice", Index().Qual("net/netip", "AddrPort")},
+	{"ToURLSlice", Index().Op("*").Qual("net/url", "URL")},
+	{"ToRegexpSlice", Index().Op("*").Qual("regexp", "Regexp")},
+	{"ToGlobSlice", Index().Op("*").Qual("regexp", "Regexp")},
 }
 
 var toSliceFuncs = []struct {
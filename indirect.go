@@ -6,6 +6,7 @@
 package cast
 
 import (
+	"database/sql/driver"
 	"reflect"
 )
 
@@ -35,3 +36,20 @@ func indirect(i any) (any, bool) {
 
 	return v.Interface(), true
 }
+
+// indirectValuer unwraps values implementing [driver.Valuer] (like the sql.Null* types) to their underlying value.
+//
+// Invalid (NULL) values are unwrapped to nil.
+func indirectValuer(i any) (any, bool) {
+	v, ok := i.(driver.Valuer)
+	if !ok {
+		return i, false
+	}
+
+	val, err := v.Value()
+	if err != nil {
+		return i, false
+	}
+
+	return val, true
+}
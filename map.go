@@ -11,197 +11,277 @@ import (
 	"reflect"
 )
 
-func toMapE[K comparable, V any](i any, keyFn func(any) K, valFn func(any) V) (map[K]V, error) {
+// KeyError records a failure to convert an entry of a map.
+type KeyError struct {
+	// Key is the original (unconverted) key of the entry.
+	Key any
+
+	// Err is the underlying conversion error.
+	Err error
+}
+
+func (e *KeyError) Error() string {
+	return fmt.Sprintf("key %#v: %v", e.Key, e.Err)
+}
+
+func (e *KeyError) Unwrap() error {
+	return e.Err
+}
+
+// convertMap converts every entry of src using keyFn and valFn.
+//
+// Failing entries are skipped, so that (unlike when stopping at the first failure)
+// the result does not depend on the iteration order of src.
+// Only the first failure is reported (as a [KeyError]) unless allErrors is true.
+func convertMap[SK comparable, SV any, K comparable, V any](m map[K]V, src map[SK]SV, keyFn func(any) (K, error), valFn func(any) (V, error), allErrors bool) []error {
+	var errs []error
+
+	for k, val := range src {
+		if err := setMapEntry(m, k, val, keyFn, valFn); err != nil && (allErrors || len(errs) == 0) {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// convertEntries converts the entries of an ordered map using keyFn and valFn.
+//
+// Conversion stops at the first failing entry unless allErrors is true.
+func convertEntries[K comparable, V any](m map[K]V, entries []KeyValue[any, any], keyFn func(any) (K, error), valFn func(any) (V, error), allErrors bool) []error {
+	var errs []error
+
+	for _, e := range entries {
+		if err := setMapEntry(m, e.Key, e.Value, keyFn, valFn); err != nil {
+			errs = append(errs, err)
+
+			if !allErrors {
+				break
+			}
+		}
+	}
+
+	return errs
+}
+
+func setMapEntry[K comparable, V any](m map[K]V, k any, val any, keyFn func(any) (K, error), valFn func(any) (V, error)) error {
+	key, value, err := convertEntry(k, val, keyFn, valFn)
+	if err != nil {
+		return err
+	}
+
+	m[key] = value
+
+	return nil
+}
+
+// convertEntry converts a single key/value pair, reporting failures as a [KeyError].
+func convertEntry[K comparable, V any](k any, val any, keyFn func(any) (K, error), valFn func(any) (V, error)) (K, V, error) {
+	var key K
+	var value V
+
+	key, err := keyFn(k)
+	if err != nil {
+		return key, value, &KeyError{Key: k, Err: err}
+	}
+
+	value, err = valFn(val)
+	if err != nil {
+		return key, value, &KeyError{Key: k, Err: err}
+	}
+
+	return key, value, nil
+}
+
+// convertReflectMap converts every entry of an arbitrary map value (e.g. named map types or maps with non-basic key types)
+// using keyFn and valFn, skipping failing entries like [convertMap].
+//
+// It returns false if i is not a map.
+func convertReflectMap[K comparable, V any](m map[K]V, i any, keyFn func(any) (K, error), valFn func(any) (V, error), allErrors bool) ([]error, bool) {
+	v := reflect.ValueOf(i)
+	if v.Kind() != reflect.Map {
+		return nil, false
+	}
+
+	var errs []error
+
+	iter := v.MapRange()
+	for iter.Next() {
+		if err := setMapEntry(m, iter.Key().Interface(), iter.Value().Interface(), keyFn, valFn); err != nil && (allErrors || len(errs) == 0) {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs, true
+}
+
+func toMapE[K comparable, V any](i any, keyFn func(any) (K, error), valFn func(any) (V, error), opts ...Option) (map[K]V, error) {
+	i, _ = indirect(i)
+
 	m := map[K]V{}
 
 	if i == nil {
 		return m, fmt.Errorf(errorMsg, i, i, m)
 	}
 
+	c := newConfig(opts)
+
 	switch v := i.(type) {
 	case map[K]V:
 		return v, nil
 
 	case map[K]any:
-		for k, val := range v {
-			m[k] = valFn(val)
-		}
+		errs := convertMap(m, v, keyFn, valFn, c.allErrors)
 
-		return m, nil
+		return m, wrapErrors(i, m, errs)
 
 	case map[any]V:
-		for k, val := range v {
-			m[keyFn(k)] = val
-		}
+		errs := convertMap(m, v, keyFn, valFn, c.allErrors)
 
-		return m, nil
+		return m, wrapErrors(i, m, errs)
 
 	case map[any]any:
-		for k, val := range v {
-			m[keyFn(k)] = valFn(val)
-		}
+		errs := convertMap(m, v, keyFn, valFn, c.allErrors)
 
-		return m, nil
+		return m, wrapErrors(i, m, errs)
 
 	case string:
 		err := jsonStringToObject(v, &m)
 		return m, err
 
 	default:
-		return m, fmt.Errorf(errorMsg, i, i, m)
+		if entries, ok := orderedEntries(i); ok {
+			errs := convertEntries(m, entries, keyFn, valFn, c.allErrors)
+
+			return m, wrapErrors(i, m, errs)
+		}
+
+		errs, ok := convertReflectMap(m, i, keyFn, valFn, c.allErrors)
+		if !ok {
+			return m, fmt.Errorf(errorMsg, i, i, m)
+		}
+
+		return m, wrapErrors(i, m, errs)
 	}
 }
 
-func toStringMapE[T any](i any, fn func(any) T) (map[string]T, error) {
-	return toMapE(i, ToString, fn)
+func toStringMapE[T any](i any, fn func(any) (T, error)) (map[string]T, error) {
+	return toMapE(i, ToStringE, fn)
+}
+
+// ToMapE casts any value to a map[K]V type, converting keys and values using [ToWithE].
+//
+// Unlike the ToStringMap* functions, it accepts [Option] values (e.g. [WithAllErrors]).
+// The options are also applied to the key and value conversions.
+func ToMapE[K, V Basic](i any, opts ...Option) (map[K]V, error) {
+	return toMapE(i, toFunc[K](opts), toFunc[V](opts), opts...)
+}
+
+// ToMap casts any value to a map[K]V type, converting keys and values using [ToE].
+func ToMap[K, V Basic](i any) map[K]V {
+	v, _ := ToMapE[K, V](i)
+
+	return v
 }
 
 // ToStringMapStringE casts any value to a map[string]string type.
 func ToStringMapStringE(i any) (map[string]string, error) {
-	return toStringMapE(i, ToString)
+	return toStringMapE(i, ToStringE)
 }
 
 // ToStringMapStringSliceE casts any value to a map[string][]string type.
 func ToStringMapStringSliceE(i any) (map[string][]string, error) {
+	i, _ = indirect(i)
+
 	m := map[string][]string{}
 
+	var errs []error
+
 	switch v := i.(type) {
 	case map[string][]string:
 		return v, nil
 	case map[string][]any:
-		for k, val := range v {
-			m[ToString(k)] = ToStringSlice(val)
-		}
-		return m, nil
+		errs = convertMap(m, v, ToStringE, ToStringSliceE, false)
 	case map[string]string:
 		for k, val := range v {
-			m[ToString(k)] = []string{val}
+			m[k] = []string{val}
 		}
 	case map[string]any:
-		for k, val := range v {
-			switch vt := val.(type) {
-			case []any:
-				m[ToString(k)] = ToStringSlice(vt)
-			case []string:
-				m[ToString(k)] = vt
-			default:
-				m[ToString(k)] = []string{ToString(val)}
-			}
-		}
-		return m, nil
+		errs = convertMap(m, v, ToStringE, toStringSliceValueE, false)
 	case map[any][]string:
-		for k, val := range v {
-			m[ToString(k)] = ToStringSlice(val)
-		}
-		return m, nil
+		errs = convertMap(m, v, ToStringE, ToStringSliceE, false)
 	case map[any]string:
-		for k, val := range v {
-			m[ToString(k)] = ToStringSlice(val)
-		}
-		return m, nil
+		errs = convertMap(m, v, ToStringE, ToStringSliceE, false)
 	case map[any][]any:
-		for k, val := range v {
-			m[ToString(k)] = ToStringSlice(val)
-		}
-		return m, nil
+		errs = convertMap(m, v, ToStringE, ToStringSliceE, false)
 	case map[any]any:
-		for k, val := range v {
-			key, err := ToStringE(k)
-			if err != nil {
-				return m, fmt.Errorf(errorMsg, i, i, m)
-			}
-			value, err := ToStringSliceE(val)
-			if err != nil {
-				return m, fmt.Errorf(errorMsg, i, i, m)
-			}
-			m[key] = value
-		}
+		errs = convertMap(m, v, ToStringE, ToStringSliceE, false)
 	case string:
 		err := jsonStringToObject(v, &m)
 		return m, err
 	default:
-		return m, fmt.Errorf(errorMsg, i, i, m)
-	}
-
-	return m, nil
-}
-
-// ToStringMapBoolE casts any value to a map[string]bool type.
-func ToStringMapBoolE(i any) (map[string]bool, error) {
-	return toStringMapE(i, ToBool)
-}
+		var ok bool
 
-// ToStringMapE casts any value to a map[string]any type.
-func ToStringMapE(i any) (map[string]any, error) {
-	fn := func(i any) any { return i }
-
-	return toStringMapE(i, fn)
-}
-
-func toStringMapIntE[T int | int64](i any, fn func(any) T, fnE func(any) (T, error)) (map[string]T, error) {
-	m := map[string]T{}
-
-	if i == nil {
-		return nil, fmt.Errorf(errorMsg, i, i, m)
-	}
-
-	switch v := i.(type) {
-	case map[string]T:
-		return v, nil
-
-	case map[string]any:
-		for k, val := range v {
-			m[k] = fn(val)
+		errs, ok = convertReflectMap(m, i, ToStringE, toStringSliceValueE, false)
+		if !ok {
+			return m, fmt.Errorf(errorMsg, i, i, m)
 		}
+	}
 
-		return m, nil
-
-	case map[any]T:
-		for k, val := range v {
-			m[ToString(k)] = val
-		}
+	return m, wrapErrors(i, m, errs)
+}
 
-		return m, nil
+// toStringSliceValueE converts a map value to a []string:
+// slices and arrays (except []byte) are converted element by element, any other value becomes a single element slice.
+func toStringSliceValueE(i any) ([]string, error) {
+	i, _ = indirect(i)
 
-	case map[any]any:
-		for k, val := range v {
-			m[ToString(k)] = fn(val)
+	switch i.(type) {
+	case nil, []byte:
+	default:
+		if kind := reflect.TypeOf(i).Kind(); kind == reflect.Slice || kind == reflect.Array {
+			return ToStringSliceE(i)
 		}
-
-		return m, nil
-
-	case string:
-		err := jsonStringToObject(v, &m)
-		return m, err
 	}
 
-	if reflect.TypeOf(i).Kind() != reflect.Map {
-		return m, fmt.Errorf(errorMsg, i, i, m)
+	s, err := ToStringE(i)
+	if err != nil {
+		return nil, err
 	}
 
-	mVal := reflect.ValueOf(m)
-	v := reflect.ValueOf(i)
+	return []string{s}, nil
+}
 
-	for _, keyVal := range v.MapKeys() {
-		val, err := fnE(v.MapIndex(keyVal).Interface())
-		if err != nil {
-			return m, fmt.Errorf(errorMsg, i, i, m)
-		}
+// ToStringMapBoolE casts any value to a map[string]bool type.
+func ToStringMapBoolE(i any) (map[string]bool, error) {
+	return toStringMapE(i, ToBoolE)
+}
 
-		mVal.SetMapIndex(keyVal, reflect.ValueOf(val))
-	}
+// ToStringMapE casts any value to a map[string]any type.
+func ToStringMapE(i any) (map[string]any, error) {
+	fn := func(i any) (any, error) { return i, nil }
 
-	return m, nil
+	return toStringMapE(i, fn)
 }
 
 // ToStringMapIntE casts any value to a map[string]int type.
 func ToStringMapIntE(i any) (map[string]int, error) {
-	return toStringMapIntE(i, ToInt, ToIntE)
+	return toStringMapIntE(i, ToIntE)
 }
 
 // ToStringMapInt64E casts any value to a map[string]int64 type.
 func ToStringMapInt64E(i any) (map[string]int64, error) {
-	return toStringMapIntE(i, ToInt64, ToInt64E)
+	return toStringMapIntE(i, ToInt64E)
+}
+
+// toStringMapIntE is like toStringMapE, but (unlike the other map conversions) returns a nil map for nil input.
+func toStringMapIntE[T int | int64](i any, fn func(any) (T, error)) (map[string]T, error) {
+	m, err := toStringMapE(i, fn)
+	if i, _ := indirect(i); i == nil {
+		return nil, err
+	}
+
+	return m, err
 }
 
 // jsonStringToObject attempts to unmarshall a string as JSON into
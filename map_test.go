@@ -6,6 +6,7 @@
 package cast_test
 
 import (
+	"errors"
 	"testing"
 
 	qt "github.com/frankban/quicktest"
@@ -136,6 +137,12 @@ func runMapTests[K comparable, V cast.Basic | any](t *testing.T, testCases []tes
 	}
 }
 
+type stringMap map[string]string
+
+type stringMapStringSliceMap map[string][]string
+
+var trueValue = true
+
 func TestStringMapStringSlice(t *testing.T) {
 	// ToStringMapString inputs/outputs
 	var stringMapString = map[string]string{"key 1": "value 1", "key 2": "value 2", "key 3": "value 3"}
@@ -179,6 +186,9 @@ func TestStringMapStringSlice(t *testing.T) {
 		{interfaceMapString, stringMapStringSingleSliceFieldsResult, false},
 		{interfaceMapInterface, stringMapStringSingleSliceFieldsResult, false},
 		{jsonStringMapStringArray, jsonStringMapStringArrayResult, false},
+		{map[int][]int{1: {1, 2}}, map[string][]string{"1": {"1", "2"}}, false},
+		{map[string]any{"key 1": []int{1, 2}, "key 2": []byte("value")}, map[string][]string{"key 1": {"1", "2"}, "key 2": {"value"}}, false},
+		{stringMapStringSliceMap(stringMapStringSlice), stringMapStringSlice, false},
 
 		// Failure cases
 		{nil, map[string][]string{}, true},
@@ -198,6 +208,8 @@ func TestStringMap(t *testing.T) {
 		{map[string]any{"tag": "tags", "group": "groups"}, map[string]any{"tag": "tags", "group": "groups"}, false},
 		{`{"tag": "tags", "group": "groups"}`, map[string]any{"tag": "tags", "group": "groups"}, false},
 		{`{"tag": "tags", "group": true}`, map[string]any{"tag": "tags", "group": true}, false},
+		{map[MyString]int{"tag": 1}, map[string]any{"tag": 1}, false},
+		{&map[string]any{"tag": "tags"}, map[string]any{"tag": "tags"}, false},
 
 		// Failure cases
 		{nil, map[string]any{}, true},
@@ -214,8 +226,11 @@ func TestStringMapBool(t *testing.T) {
 		{map[string]any{"v1": true, "v2": false}, map[string]bool{"v1": true, "v2": false}, false},
 		{map[string]bool{"v1": true, "v2": false}, map[string]bool{"v1": true, "v2": false}, false},
 		{`{"v1": true, "v2": false}`, map[string]bool{"v1": true, "v2": false}, false},
+		{map[string]int{"v1": 1, "v2": 0}, map[string]bool{"v1": true, "v2": false}, false},
+		{map[string]*bool{"v1": &trueValue}, map[string]bool{"v1": true}, false},
 
 		// Failure cases
+		{map[string]any{"v1": "banana"}, map[string]bool{}, true},
 		{nil, map[string]bool{}, true},
 		{testing.T{}, map[string]bool{}, true},
 		{"", map[string]bool{}, true},
@@ -235,6 +250,8 @@ func TestStringMapInt(t *testing.T) {
 		{`{"v1": 67, "v2": 56}`, map[string]int{"v1": 67, "v2": 56}, false},
 
 		// Failure cases
+		{map[string]any{"v1": "banana"}, map[string]int{}, true},
+		{map[string]string{"v1": "banana"}, map[string]int{}, true},
 		{nil, map[string]int{}, true},
 		{testing.T{}, map[string]int{}, true},
 		{"", map[string]int{}, true},
@@ -263,6 +280,27 @@ func TestStringMapInt64(t *testing.T) {
 	runMapTests(t, testCases, cast.ToStringMapInt64, cast.ToStringMapInt64E)
 }
 
+func TestStringMapIntNil(t *testing.T) {
+	c := qt.New(t)
+
+	// Unlike the other map conversions, nil input results in a nil map
+	m, err := cast.ToStringMapIntE(nil)
+	c.Assert(err, qt.IsNotNil)
+	c.Assert(m, qt.IsNil)
+
+	m64, err := cast.ToStringMapInt64E(nil)
+	c.Assert(err, qt.IsNotNil)
+	c.Assert(m64, qt.IsNil)
+
+	var p *map[string]any
+
+	m, err = cast.ToStringMapIntE(p)
+	c.Assert(err, qt.IsNotNil)
+	c.Assert(m, qt.IsNil)
+
+	c.Assert(cast.ToStringMapString(nil), qt.IsNotNil)
+}
+
 func TestStringMapString(t *testing.T) {
 	var stringMapString = map[string]string{"key 1": "value 1", "key 2": "value 2", "key 3": "value 3"}
 	var stringMapInterface = map[string]any{"key 1": "value 1", "key 2": "value 2", "key 3": "value 3"}
@@ -278,8 +316,13 @@ func TestStringMapString(t *testing.T) {
 		{interfaceMapString, stringMapString, false},
 		{interfaceMapInterface, stringMapString, false},
 		{jsonString, stringMapString, false},
+		{map[string]int{"key 1": 1}, map[string]string{"key 1": "1"}, false},
+		{map[int]string{1: "value 1"}, map[string]string{"1": "value 1"}, false},
+		{stringMap(stringMapString), stringMapString, false},
 
 		// Failure cases
+		{map[string]any{"key 1": testing.T{}}, map[string]string{}, true},
+		{map[any]any{struct{ k string }{"key 1"}: "value 1"}, map[string]string{}, true},
 		{nil, map[string]string{}, true},
 		{testing.T{}, map[string]string{}, true},
 		{invalidJsonString, map[string]string{}, true},
@@ -288,3 +331,71 @@ func TestStringMapString(t *testing.T) {
 
 	runMapTests(t, testCases, cast.ToStringMapString, cast.ToStringMapStringE)
 }
+
+func TestMap(t *testing.T) {
+	testCases := []testCase{
+		{map[any]any{"v1": "1", "v2": 2.0}, map[string]int{"v1": 1, "v2": 2}, false},
+		{map[string]any{"v1": "1", "v2": true}, map[string]int{"v1": 1, "v2": 1}, false},
+		{map[string]int{"v1": 1, "v2": 2}, map[string]int{"v1": 1, "v2": 2}, false},
+
+		// Failure cases
+		{map[string]any{"v1": "banana"}, map[string]int{}, true},
+		{nil, map[string]int{}, true},
+		{testing.T{}, map[string]int{}, true},
+	}
+
+	toErr := func(i any) (map[string]int, error) {
+		return cast.ToMapE[string, int](i)
+	}
+
+	runMapTests(t, testCases, cast.ToMap[string, int], toErr)
+}
+
+func TestMapErrors(t *testing.T) {
+	c := qt.New(t)
+
+	input := map[string]any{"v1": "banana", "v2": 2, "v3": "apple"}
+
+	_, err := cast.ToMapE[string, int](input)
+	c.Assert(err, qt.IsNotNil)
+
+	var keyErr *cast.KeyError
+	c.Assert(errors.As(err, &keyErr), qt.IsTrue)
+	c.Assert(keyErr.Key, qt.Not(qt.Equals), "v2")
+
+	_, err = cast.ToMapE[string, int](input, cast.WithAllErrors())
+	c.Assert(err, qt.IsNotNil)
+
+	joined, ok := errors.Unwrap(err).(interface{ Unwrap() []error })
+	c.Assert(ok, qt.IsTrue)
+
+	var keys []any
+	for _, err := range joined.Unwrap() {
+		c.Assert(errors.As(err, &keyErr), qt.IsTrue)
+		keys = append(keys, keyErr.Key)
+	}
+
+	c.Assert(keys, qt.HasLen, 2)
+	c.Assert(keys, qt.Contains, "v1")
+	c.Assert(keys, qt.Contains, "v3")
+}
+
+func TestMapFailingEntries(t *testing.T) {
+	c := qt.New(t)
+
+	input := map[string]any{"a": true, "b": "banana", "c": "true", "d": "cherry", "e": 1, "f": 0}
+	expected := map[string]bool{"a": true, "c": true, "e": true, "f": false}
+
+	// Named maps are converted using reflection
+	type namedMap map[string]any
+
+	// Failing entries are skipped, so the result does not depend on the iteration order
+	for j := 0; j < 10; j++ {
+		m, err := cast.ToStringMapBoolE(input)
+		c.Assert(err, qt.IsNotNil)
+		c.Assert(m, qt.DeepEquals, expected)
+
+		c.Assert(cast.ToStringMapBool(input), qt.DeepEquals, expected)
+		c.Assert(cast.ToMap[string, bool](namedMap(input)), qt.DeepEquals, expected)
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+)
+
+// ToIPE casts any value to a [net.IP] type.
+func ToIPE(i any) (net.IP, error) {
+	i, _ = indirect(i)
+
+	switch v := i.(type) {
+	case net.IP:
+		return v, nil
+	case netip.Addr:
+		if !v.IsValid() {
+			return nil, nil
+		}
+
+		return net.IP(v.AsSlice()), nil
+	case nil:
+		return nil, nil
+	}
+
+	s, err := ToStringE(i)
+	if err != nil {
+		return nil, fmt.Errorf(errorMsgWith, i, i, net.IP{}, err)
+	}
+
+	if s == "" {
+		return nil, nil
+	}
+
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, fmt.Errorf(errorMsg, i, i, net.IP{})
+	}
+
+	return ip, nil
+}
+
+// ToAddrE casts any value to a [netip.Addr] type.
+//
+// IPv4-mapped IPv6 addresses in [net.IP] values are converted to IPv4 addresses.
+func ToAddrE(i any) (netip.Addr, error) {
+	i, _ = indirect(i)
+
+	if ip, ok := i.(net.IP); ok && len(ip) > 0 {
+		addr, ok := netip.AddrFromSlice(ip)
+		if !ok {
+			return netip.Addr{}, fmt.Errorf(errorMsg, i, i, netip.Addr{})
+		}
+
+		return addr.Unmap(), nil
+	}
+
+	return toTextValueE[netip.Addr](i)
+}
+
+// ToPrefixE casts any value to a [netip.Prefix] type.
+func ToPrefixE(i any) (netip.Prefix, error) {
+	return toTextValueE[netip.Prefix](i)
+}
+
+// ToAddrPortE casts any value to a [netip.AddrPort] type.
+func ToAddrPortE(i any) (netip.AddrPort, error) {
+	return toTextValueE[netip.AddrPort](i)
+}
+
+// ToURLE casts any value to a [*url.URL] type.
+func ToURLE(i any) (*url.URL, error) {
+	if u, ok := i.(*url.URL); ok {
+		return u, nil
+	}
+
+	i, _ = indirect(i)
+
+	switch v := i.(type) {
+	case url.URL:
+		return &v, nil
+	case nil:
+		return nil, nil
+	}
+
+	s, err := ToStringE(i)
+	if err != nil {
+		return nil, fmt.Errorf(errorMsgWith, i, i, &url.URL{}, err)
+	}
+
+	if s == "" {
+		return nil, nil
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf(errorMsgWith, i, i, u, err)
+	}
+
+	return u, nil
+}
+
+// ToIPSliceE casts any value to a []net.IP type.
+func ToIPSliceE(i any) ([]net.IP, error) {
+	return toNetSliceE(i, ToIPE)
+}
+
+// ToAddrSliceE casts any value to a []netip.Addr type.
+func ToAddrSliceE(i any) ([]netip.Addr, error) {
+	return toNetSliceE(i, ToAddrE)
+}
+
+// ToPrefixSliceE casts any value to a []netip.Prefix type.
+func ToPrefixSliceE(i any) ([]netip.Prefix, error) {
+	return toNetSliceE(i, ToPrefixE)
+}
+
+// ToAddrPortSliceE casts any value to a []netip.AddrPort type.
+func ToAddrPortSliceE(i any) ([]netip.AddrPort, error) {
+	return toNetSliceE(i, ToAddrPortE)
+}
+
+// ToURLSliceE casts any value to a []*url.URL type.
+func ToURLSliceE(i any) ([]*url.URL, error) {
+	return toNetSliceE(i, ToURLE)
+}
+
+// toNetSliceE casts any value to a slice of network values using fn.
+//
+// Inputs are handled like in [ToStringSliceE], except that byte slices are treated as text (like strings).
+func toNetSliceE[T any](i any, fn func(any) (T, error)) ([]T, error) {
+	v, _ := indirect(i)
+	if b, ok := v.([]byte); ok {
+		i = string(b)
+	}
+
+	return toStringlikeSliceE(i, fn)
+}
@@ -0,0 +1,201 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast_test
+
+import (
+	"net"
+	"net/netip"
+	"net/url"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"github.com/spf13/cast"
+)
+
+func TestIP(t *testing.T) {
+	testCases := []testCase{
+		{"192.168.0.1", net.ParseIP("192.168.0.1"), false},
+		{[]byte("192.168.0.1"), net.ParseIP("192.168.0.1"), false},
+		{"::1", net.ParseIP("::1"), false},
+		{net.ParseIP("192.168.0.1"), net.ParseIP("192.168.0.1"), false},
+		{netip.MustParseAddr("192.168.0.1"), net.IP{192, 168, 0, 1}, false},
+		{"", net.IP(nil), false},
+		{nil, net.IP(nil), false},
+
+		// Failure cases
+		{"192.168.0", net.IP(nil), true},
+		{testing.T{}, net.IP(nil), true},
+	}
+
+	runConversionTests(t, testCases, cast.ToIP, cast.ToIPE)
+}
+
+func TestAddr(t *testing.T) {
+	addr := netip.MustParseAddr("192.168.0.1")
+
+	testCases := []testCase{
+		{"192.168.0.1", addr, false},
+		{[]byte("192.168.0.1"), addr, false},
+		{net.ParseIP("192.168.0.1"), addr, false},
+		{net.IP{192, 168, 0, 1}, addr, false},
+		{addr, addr, false},
+		{&addr, addr, false},
+		{"fe80::1", netip.MustParseAddr("fe80::1"), false},
+		{"", netip.Addr{}, false},
+		{nil, netip.Addr{}, false},
+
+		// Failure cases
+		{"192.168.0", netip.Addr{}, true},
+		{net.IP{192, 168, 0}, netip.Addr{}, true},
+		{testing.T{}, netip.Addr{}, true},
+	}
+
+	runConversionTests(t, testCases, cast.ToAddr, cast.ToAddrE)
+}
+
+func TestPrefix(t *testing.T) {
+	prefix := netip.MustParsePrefix("192.168.0.0/24")
+
+	_, ipNet, _ := net.ParseCIDR("192.168.0.0/24")
+
+	testCases := []testCase{
+		{"192.168.0.0/24", prefix, false},
+		{prefix, prefix, false},
+		{ipNet, prefix, false},
+		{*ipNet, prefix, false},
+		{"", netip.Prefix{}, false},
+
+		// Failure cases
+		{"192.168.0.0", netip.Prefix{}, true},
+		{"192.168.0.0/33", netip.Prefix{}, true},
+		{testing.T{}, netip.Prefix{}, true},
+	}
+
+	runConversionTests(t, testCases, cast.ToPrefix, cast.ToPrefixE)
+}
+
+func TestAddrPort(t *testing.T) {
+	addrPort := netip.MustParseAddrPort("192.168.0.1:8080")
+
+	testCases := []testCase{
+		{"192.168.0.1:8080", addrPort, false},
+		{addrPort, addrPort, false},
+		{"[::1]:8080", netip.MustParseAddrPort("[::1]:8080"), false},
+		{"", netip.AddrPort{}, false},
+
+		// Failure cases
+		{"192.168.0.1", netip.AddrPort{}, true},
+		{"localhost:8080", netip.AddrPort{}, true},
+		{testing.T{}, netip.AddrPort{}, true},
+	}
+
+	runConversionTests(t, testCases, cast.ToAddrPort, cast.ToAddrPortE)
+}
+
+func TestURL(t *testing.T) {
+	u, _ := url.Parse("https://example.com/path?q=1")
+
+	testCases := []testCase{
+		{"https://example.com/path?q=1", u, false},
+		{[]byte("https://example.com/path?q=1"), u, false},
+		{u, u, false},
+		{*u, u, false},
+		{nil, (*url.URL)(nil), false},
+		{"", (*url.URL)(nil), false},
+
+		// Failure cases
+		{"http://[::1", (*url.URL)(nil), true},
+		{testing.T{}, (*url.URL)(nil), true},
+	}
+
+	runConversionTests(t, testCases, cast.ToURL, cast.ToURLE)
+}
+
+func TestNetSlices(t *testing.T) {
+	c := qt.New(t)
+
+	addrs := []netip.Addr{netip.MustParseAddr("192.168.0.1"), netip.MustParseAddr("::1")}
+
+	v, err := cast.ToAddrSliceE("192.168.0.1 ::1")
+	c.Assert(err, qt.IsNil)
+	assertDeepEqual(c, v, addrs)
+
+	v, err = cast.ToAddrSliceE([]any{"192.168.0.1", net.ParseIP("::1")})
+	c.Assert(err, qt.IsNil)
+	assertDeepEqual(c, v, addrs)
+
+	v, err = cast.ToAddrSliceE(net.ParseIP("192.168.0.1"))
+	c.Assert(err, qt.IsNil)
+	assertDeepEqual(c, v, addrs[:1])
+
+	s := "192.168.0.1 ::1"
+
+	v, err = cast.ToAddrSliceE(&s)
+	c.Assert(err, qt.IsNil)
+	assertDeepEqual(c, v, addrs)
+
+	_, err = cast.ToAddrSliceE([]string{"192.168.0.1", "test"})
+	c.Assert(err, qt.IsNotNil)
+
+	ips, err := cast.ToIPSliceE(net.ParseIP("192.168.0.1"))
+	c.Assert(err, qt.IsNil)
+	assertDeepEqual(c, ips, []net.IP{net.ParseIP("192.168.0.1")})
+
+	ips, err = cast.ToIPSliceE(&s)
+	c.Assert(err, qt.IsNil)
+	assertDeepEqual(c, ips, []net.IP{net.ParseIP("192.168.0.1"), net.ParseIP("::1")})
+
+	// Byte slices are handled like strings
+	v, err = cast.ToAddrSliceE([]byte("192.168.0.1 ::1"))
+	c.Assert(err, qt.IsNil)
+	assertDeepEqual(c, v, addrs)
+
+	ips, err = cast.ToIPSliceE([]byte("192.168.0.1"))
+	c.Assert(err, qt.IsNil)
+	assertDeepEqual(c, ips, []net.IP{net.ParseIP("192.168.0.1")})
+
+	prefixes, err := cast.ToPrefixSliceE([]byte("192.168.0.0/24"))
+	c.Assert(err, qt.IsNil)
+	assertDeepEqual(c, prefixes, []netip.Prefix{netip.MustParsePrefix("192.168.0.0/24")})
+
+	prefixes, err = cast.ToPrefixSliceE([]string{"192.168.0.0/24", "fe80::/10"})
+	c.Assert(err, qt.IsNil)
+	assertDeepEqual(c, prefixes, []netip.Prefix{netip.MustParsePrefix("192.168.0.0/24"), netip.MustParsePrefix("fe80::/10")})
+
+	addrPorts, err := cast.ToAddrPortSliceE("192.168.0.1:80 [::1]:443")
+	c.Assert(err, qt.IsNil)
+	assertDeepEqual(c, addrPorts, []netip.AddrPort{netip.MustParseAddrPort("192.168.0.1:80"), netip.MustParseAddrPort("[::1]:443")})
+
+	urls, err := cast.ToURLSliceE([]string{"https://example.com", "/path"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(urls, qt.HasLen, 2)
+	c.Assert(urls[1].Path, qt.Equals, "/path")
+}
+
+func TestNetString(t *testing.T) {
+	c := qt.New(t)
+
+	u, _ := url.Parse("https://example.com/path?q=1")
+	_, ipNet, _ := net.ParseCIDR("192.168.0.0/24")
+
+	testCases := []testCase{
+		{net.ParseIP("192.168.0.1"), "192.168.0.1", false},
+		{netip.MustParseAddr("::1"), "::1", false},
+		{netip.MustParsePrefix("192.168.0.0/24"), "192.168.0.0/24", false},
+		{netip.MustParseAddrPort("192.168.0.1:80"), "192.168.0.1:80", false},
+		{u, "https://example.com/path?q=1", false},
+		{*u, "https://example.com/path?q=1", false},
+		{ipNet, "192.168.0.0/24", false},
+		{*ipNet, "192.168.0.0/24", false},
+	}
+
+	for _, testCase := range testCases {
+		v, err := cast.ToStringE(testCase.input)
+		c.Assert(err, qt.IsNil)
+		c.Assert(v, qt.Equals, testCase.expected)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast
+
+import (
+	"encoding/json"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// normalizeInput normalizes string and [json.Number] inputs if normalization is enabled (see [WithNormalization]).
+func (c config) normalizeInput(i any) any {
+	if !c.normalize {
+		return i
+	}
+
+	// Other values are returned as is (without dereferencing pointers)
+	v, _ := indirect(i)
+
+	switch s := v.(type) {
+	case string:
+		return normalizeString(s)
+	case json.Number:
+		return json.Number(normalizeString(string(s)))
+	}
+
+	if v, ok := resolveAlias(v); ok {
+		if s, ok := v.(string); ok {
+			return normalizeString(s)
+		}
+	}
+
+	return i
+}
+
+// normalizeString trims white space, removes no-break and zero width spaces,
+// and folds fullwidth characters and decimal digits of any script to ASCII.
+func normalizeString(s string) string {
+	s = strings.Map(func(r rune) rune {
+		switch {
+		case r < utf8.RuneSelf:
+			return r
+		case r == '\u00a0', r == '\u2007', r == '\u202f', r == '\u200b', r == '\ufeff':
+			return -1
+		case r == '\u3000':
+			// Ideographic space
+			return ' '
+		case r >= '\uff01' && r <= '\uff5e':
+			// Fullwidth forms of ASCII characters
+			return r - 0xff01 + '!'
+		case unicode.Is(unicode.Nd, r):
+			return foldDigit(r)
+		default:
+			return r
+		}
+	}, s)
+
+	return strings.TrimSpace(s)
+}
+
+// foldDigit returns the ASCII digit with the value of the decimal digit r.
+//
+// Unicode encodes decimal digits in contiguous runs starting at zero,
+// so the value is the distance from the start of the run (modulo 10, as some runs contain multiple sets of digits).
+func foldDigit(r rune) rune {
+	zero := r
+	for unicode.Is(unicode.Nd, zero-1) {
+		zero--
+	}
+
+	return '0' + (r-zero)%10
+}
@@ -0,0 +1,102 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+
+	"github.com/spf13/cast"
+)
+
+func TestNormalization(t *testing.T) {
+	normalize := cast.WithNormalization()
+
+	testCases := []struct {
+		input    any
+		to       func(i any, opts ...cast.Option) (any, error)
+		expected any
+	}{
+		{"  42 ", toWithE[int], 42},
+		{"42\n", toWithE[uint8], uint8(42)},
+		{" 42 ", toWithE[int64], int64(42)},
+		{"1\u202f000", toWithE[int], 1000},
+		{"４２", toWithE[int], 42},
+		{"－４２．５", toWithE[float64], -42.5},
+		{"٤٢", toWithE[int], 42},
+		{"४२", toWithE[int], 42},
+		{"\U0001d7f0\U0001d7ee", toWithE[int], 42},
+		{"\u200b42", toWithE[int], 42},
+		{json.Number(" 42 "), toWithE[int], 42},
+		{MyString(" 42 "), toWithE[int], 42},
+		{"true\n", toWithE[bool], true},
+		{"\u3000ｔｒｕｅ", toWithE[bool], true},
+		{" 1m30s ", toWithE[time.Duration], 90 * time.Second},
+		{" 2024-01-02 ", toWithE[time.Time], time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{" 1+2i ", toWithE[complex128], complex(1, 2)},
+
+		// String conversions are not normalized
+		{" 42 ", toWithE[string], " 42 "},
+	}
+
+	for _, testCase := range testCases {
+		// TODO: remove after minimum Go version is >=1.22
+		testCase := testCase
+
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			c := qt.New(t)
+
+			v, err := testCase.to(testCase.input, normalize)
+			c.Assert(err, qt.IsNil)
+			c.Assert(v, qt.Equals, testCase.expected)
+
+			// Pointers are normalized as well
+			v, err = testCase.to(&testCase.input, normalize)
+			c.Assert(err, qt.IsNil)
+			c.Assert(v, qt.Equals, testCase.expected)
+		})
+	}
+}
+
+func TestNormalizationOptions(t *testing.T) {
+	c := qt.New(t)
+
+	// Options can be defined once and reused
+	userInput := cast.Options(cast.WithNormalization(), cast.WithNumberFormat(cast.NumberFormatFrench))
+
+	v, err := cast.ToNumberWithE[float64]("\u00a0１ ２３４,５ ", userInput)
+	c.Assert(err, qt.IsNil)
+	c.Assert(v, qt.Equals, 1234.5)
+
+	s, err := cast.ToSliceOfE[int]([]string{" 1 ", "２"}, userInput)
+	c.Assert(err, qt.IsNil)
+	c.Assert(s, qt.DeepEquals, []int{1, 2})
+
+	m, err := cast.ToMapE[string, bool](map[string]any{"a": " true "}, userInput)
+	c.Assert(err, qt.IsNil)
+	c.Assert(m, qt.DeepEquals, map[string]bool{"a": true})
+
+	// Named string types are not normalized either, by any conversion
+	ms, err := cast.ToWithE[MyString](" foo ", userInput)
+	c.Assert(err, qt.IsNil)
+	c.Assert(ms, qt.Equals, MyString(" foo "))
+
+	ms, err = cast.AsE[MyString](" foo ", userInput)
+	c.Assert(err, qt.IsNil)
+	c.Assert(ms, qt.Equals, MyString(" foo "))
+
+	// Normalization is opt-in
+	_, err = cast.ToIntE(" 42 ")
+	c.Assert(err, qt.IsNotNil)
+
+	_, err = cast.ToWithE[int]("４２")
+	c.Assert(err, qt.IsNotNil)
+}
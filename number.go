@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"regexp"
 	"strconv"
 	"strings"
@@ -17,6 +18,13 @@ import (
 
 var errNegativeNotAllowed = errors.New("unable to cast negative value")
 
+var (
+	errLeadingZeros = errors.New("leading zeros are not allowed")
+	errUnderscores  = errors.New("underscores are not allowed")
+	errFraction     = errors.New("fractional part is not allowed")
+	errNonFinite    = errors.New("non-finite value (NaN or infinity) is not allowed")
+)
+
 type float64EProvider interface {
 	Float64() (float64, error)
 }
@@ -46,33 +54,42 @@ type float interface {
 
 // ToNumberE casts any value to a [Number] type.
 func ToNumberE[T Number](i any) (T, error) {
+	return ToNumberWithE[T](i)
+}
+
+// ToNumberWithE casts any value to a [Number] type using the given options (e.g. [WithNumberFormat]).
+func ToNumberWithE[T Number](i any, opts ...Option) (T, error) {
+	c := newConfig(opts)
+
+	i = c.normalizeInput(i)
+
 	var t T
 
 	switch any(t).(type) {
 	case int:
-		return toNumberE[T](i, parseNumber[T])
+		return toNumberE[T](i, parseNumber[T], c)
 	case int8:
-		return toNumberE[T](i, parseNumber[T])
+		return toNumberE[T](i, parseNumber[T], c)
 	case int16:
-		return toNumberE[T](i, parseNumber[T])
+		return toNumberE[T](i, parseNumber[T], c)
 	case int32:
-		return toNumberE[T](i, parseNumber[T])
+		return toNumberE[T](i, parseNumber[T], c)
 	case int64:
-		return toNumberE[T](i, parseNumber[T])
+		return toNumberE[T](i, parseNumber[T], c)
 	case uint:
-		return toUnsignedNumberE[T](i, parseNumber[T])
+		return toUnsignedNumberE[T](i, parseNumber[T], c)
 	case uint8:
-		return toUnsignedNumberE[T](i, parseNumber[T])
+		return toUnsignedNumberE[T](i, parseNumber[T], c)
 	case uint16:
-		return toUnsignedNumberE[T](i, parseNumber[T])
+		return toUnsignedNumberE[T](i, parseNumber[T], c)
 	case uint32:
-		return toUnsignedNumberE[T](i, parseNumber[T])
+		return toUnsignedNumberE[T](i, parseNumber[T], c)
 	case uint64:
-		return toUnsignedNumberE[T](i, parseNumber[T])
+		return toUnsignedNumberE[T](i, parseNumber[T], c)
 	case float32:
-		return toNumberE[T](i, parseNumber[T])
+		return toNumberE[T](i, parseNumber[T], c)
 	case float64:
-		return toNumberE[T](i, parseNumber[T])
+		return toNumberE[T](i, parseNumber[T], c)
 	default:
 		return 0, fmt.Errorf("unknown number type: %T", t)
 	}
@@ -118,6 +135,18 @@ func toNumber[T Number](i any) (T, bool) {
 		return T(s), true
 	case float64:
 		return T(s), true
+	case complex64:
+		if imag(s) != 0 {
+			return 0, false
+		}
+
+		return T(real(s)), true
+	case complex128:
+		if imag(s) != 0 {
+			return 0, false
+		}
+
+		return T(real(s)), true
 	case bool:
 		if s {
 			return 1, true
@@ -135,7 +164,11 @@ func toNumber[T Number](i any) (T, bool) {
 	return 0, false
 }
 
-func toNumberE[T Number](i any, parseFn func(string) (T, error)) (T, error) {
+func toNumberE[T Number](i any, parseFn func(string) (T, error), c config) (T, error) {
+	if err := checkFiniteInput[T](i, c); err != nil {
+		return 0, err
+	}
+
 	n, ok := toNumber[T](i)
 	if ok {
 		return n, nil
@@ -149,7 +182,7 @@ func toNumberE[T Number](i any, parseFn func(string) (T, error)) (T, error) {
 			return 0, nil
 		}
 
-		v, err := parseFn(s)
+		v, err := parseNumberString(s, parseFn, c)
 		if err != nil {
 			return 0, fmt.Errorf(errorMsgWith, i, i, n, err)
 		}
@@ -160,7 +193,7 @@ func toNumberE[T Number](i any, parseFn func(string) (T, error)) (T, error) {
 			return 0, nil
 		}
 
-		v, err := parseFn(string(s))
+		v, err := parseNumberString(string(s), parseFn, c)
 		if err != nil {
 			return 0, fmt.Errorf(errorMsgWith, i, i, n, err)
 		}
@@ -176,16 +209,30 @@ func toNumberE[T Number](i any, parseFn func(string) (T, error)) (T, error) {
 			return 0, fmt.Errorf(errorMsg, i, i, n)
 		}
 
+		if err := checkFinite[T](v, c); err != nil {
+			return 0, fmt.Errorf(errorMsgWith, i, i, n, err)
+		}
+
 		return T(v), nil
 	case float64Provider:
 		if _, ok := any(n).(float64); !ok {
 			return 0, fmt.Errorf(errorMsg, i, i, n)
 		}
 
-		return T(s.Float64()), nil
+		v := s.Float64()
+
+		if err := checkFinite[T](v, c); err != nil {
+			return 0, fmt.Errorf(errorMsgWith, i, i, n, err)
+		}
+
+		return T(v), nil
 	default:
 		if i, ok := resolveAlias(i); ok {
-			return toNumberE(i, parseFn)
+			return toNumberE(i, parseFn, c)
+		}
+
+		if i, ok := indirectValuer(i); ok {
+			return toNumberE(i, parseFn, c)
 		}
 
 		return 0, fmt.Errorf(errorMsg, i, i, n)
@@ -250,6 +297,26 @@ func toUnsignedNumber[T Number](i any) (T, bool, bool) {
 		}
 
 		return T(s), true, true
+	case complex64:
+		if imag(s) != 0 {
+			return 0, true, false
+		}
+
+		if real(s) < 0 {
+			return 0, false, false
+		}
+
+		return T(real(s)), true, true
+	case complex128:
+		if imag(s) != 0 {
+			return 0, true, false
+		}
+
+		if real(s) < 0 {
+			return 0, false, false
+		}
+
+		return T(real(s)), true, true
 	case bool:
 		if s {
 			return 1, true, true
@@ -275,7 +342,11 @@ func toUnsignedNumber[T Number](i any) (T, bool, bool) {
 	return 0, true, false
 }
 
-func toUnsignedNumberE[T Number](i any, parseFn func(string) (T, error)) (T, error) {
+func toUnsignedNumberE[T Number](i any, parseFn func(string) (T, error), c config) (T, error) {
+	if err := checkFiniteInput[T](i, c); err != nil {
+		return 0, err
+	}
+
 	n, valid, ok := toUnsignedNumber[T](i)
 	if ok {
 		return n, nil
@@ -293,7 +364,7 @@ func toUnsignedNumberE[T Number](i any, parseFn func(string) (T, error)) (T, err
 			return 0, nil
 		}
 
-		v, err := parseFn(s)
+		v, err := parseNumberString(s, parseFn, c)
 		if err != nil {
 			return 0, fmt.Errorf(errorMsgWith, i, i, n, err)
 		}
@@ -304,7 +375,7 @@ func toUnsignedNumberE[T Number](i any, parseFn func(string) (T, error)) (T, err
 			return 0, nil
 		}
 
-		v, err := parseFn(string(s))
+		v, err := parseNumberString(string(s), parseFn, c)
 		if err != nil {
 			return 0, fmt.Errorf(errorMsgWith, i, i, n, err)
 		}
@@ -320,6 +391,10 @@ func toUnsignedNumberE[T Number](i any, parseFn func(string) (T, error)) (T, err
 			return 0, fmt.Errorf(errorMsg, i, i, n)
 		}
 
+		if err := checkFinite[T](v, c); err != nil {
+			return 0, fmt.Errorf(errorMsgWith, i, i, n, err)
+		}
+
 		if v < 0 {
 			return 0, errNegativeNotAllowed
 		}
@@ -332,6 +407,10 @@ func toUnsignedNumberE[T Number](i any, parseFn func(string) (T, error)) (T, err
 
 		v := s.Float64()
 
+		if err := checkFinite[T](v, c); err != nil {
+			return 0, fmt.Errorf(errorMsgWith, i, i, n, err)
+		}
+
 		if v < 0 {
 			return 0, errNegativeNotAllowed
 		}
@@ -339,13 +418,116 @@ func toUnsignedNumberE[T Number](i any, parseFn func(string) (T, error)) (T, err
 		return T(v), nil
 	default:
 		if i, ok := resolveAlias(i); ok {
-			return toUnsignedNumberE(i, parseFn)
+			return toUnsignedNumberE(i, parseFn, c)
+		}
+
+		if i, ok := indirectValuer(i); ok {
+			return toUnsignedNumberE(i, parseFn, c)
 		}
 
 		return 0, fmt.Errorf(errorMsg, i, i, n)
 	}
 }
 
+// parseNumberString parses a number string using parseFn, applying the configured number format and strictness.
+func parseNumberString[T Number](s string, parseFn func(string) (T, error), c config) (T, error) {
+	s, err := c.numberString(s)
+	if err != nil {
+		return 0, err
+	}
+
+	if c.strictNumbers {
+		if err := checkStrictNumber[T](s); err != nil {
+			return 0, err
+		}
+	}
+
+	v, err := parseFn(s)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := checkFinite[T](float64(v), c); err != nil {
+		return 0, err
+	}
+
+	return v, nil
+}
+
+// checkFiniteInput checks float (and complex) inputs using [checkFinite].
+func checkFiniteInput[T Number](i any, c config) error {
+	i, _ = indirect(i)
+
+	var f float64
+
+	switch s := i.(type) {
+	case float32:
+		f = float64(s)
+	case float64:
+		f = s
+	case complex64:
+		f = float64(real(s))
+	case complex128:
+		f = real(s)
+	default:
+		return nil
+	}
+
+	if err := checkFinite[T](f, c); err != nil {
+		var t T
+
+		return fmt.Errorf(errorMsgWith, i, i, t, err)
+	}
+
+	return nil
+}
+
+// checkFinite returns an error if f is NaN or infinite and
+// T is an integer type (which cannot represent these values) or non-finite values are rejected (see [WithFiniteFloats]).
+func checkFinite[T Number](f float64, c config) error {
+	if !math.IsNaN(f) && !math.IsInf(f, 0) {
+		return nil
+	}
+
+	var t T
+
+	switch any(t).(type) {
+	case float32, float64:
+		if !c.finiteFloats {
+			return nil
+		}
+	}
+
+	return errNonFinite
+}
+
+// checkStrictNumber makes sure s is an unambiguous base 10 number (see [WithStrictNumbers]).
+func checkStrictNumber[T Number](s string) error {
+	if strings.Contains(s, "_") {
+		return errUnderscores
+	}
+
+	_, s = cutSign(s)
+
+	// Leading zeros (including base prefixes like 0x)
+	if len(s) > 1 && s[0] == '0' && s[1] != '.' && s[1] != 'e' && s[1] != 'E' {
+		return errLeadingZeros
+	}
+
+	var t T
+
+	switch any(t).(type) {
+	case float32, float64:
+		return nil
+	}
+
+	if _, fraction, ok := strings.Cut(s, "."); ok && strings.Trim(fraction, "0") != "" {
+		return errFraction
+	}
+
+	return nil
+}
+
 func parseNumber[T Number](s string) (T, error) {
 	var t T
 
@@ -446,62 +628,62 @@ func parseFloat[T float](s string) (T, error) {
 
 // ToFloat64E casts an interface to a float64 type.
 func ToFloat64E(i any) (float64, error) {
-	return toNumberE[float64](i, parseFloat[float64])
+	return toNumberE[float64](i, parseFloat[float64], config{})
 }
 
 // ToFloat32E casts an interface to a float32 type.
 func ToFloat32E(i any) (float32, error) {
-	return toNumberE[float32](i, parseFloat[float32])
+	return toNumberE[float32](i, parseFloat[float32], config{})
 }
 
 // ToInt64E casts an interface to an int64 type.
 func ToInt64E(i any) (int64, error) {
-	return toNumberE[int64](i, parseInt[int64])
+	return toNumberE[int64](i, parseInt[int64], config{})
 }
 
 // ToInt32E casts an interface to an int32 type.
 func ToInt32E(i any) (int32, error) {
-	return toNumberE[int32](i, parseInt[int32])
+	return toNumberE[int32](i, parseInt[int32], config{})
 }
 
 // ToInt16E casts an interface to an int16 type.
 func ToInt16E(i any) (int16, error) {
-	return toNumberE[int16](i, parseInt[int16])
+	return toNumberE[int16](i, parseInt[int16], config{})
 }
 
 // ToInt8E casts an interface to an int8 type.
 func ToInt8E(i any) (int8, error) {
-	return toNumberE[int8](i, parseInt[int8])
+	return toNumberE[int8](i, parseInt[int8], config{})
 }
 
 // ToIntE casts an interface to an int type.
 func ToIntE(i any) (int, error) {
-	return toNumberE[int](i, parseInt[int])
+	return toNumberE[int](i, parseInt[int], config{})
 }
 
 // ToUintE casts an interface to a uint type.
 func ToUintE(i any) (uint, error) {
-	return toUnsignedNumberE[uint](i, parseUint[uint])
+	return toUnsignedNumberE[uint](i, parseUint[uint], config{})
 }
 
 // ToUint64E casts an interface to a uint64 type.
 func ToUint64E(i any) (uint64, error) {
-	return toUnsignedNumberE[uint64](i, parseUint[uint64])
+	return toUnsignedNumberE[uint64](i, parseUint[uint64], config{})
 }
 
 // ToUint32E casts an interface to a uint32 type.
 func ToUint32E(i any) (uint32, error) {
-	return toUnsignedNumberE[uint32](i, parseUint[uint32])
+	return toUnsignedNumberE[uint32](i, parseUint[uint32], config{})
 }
 
 // ToUint16E casts an interface to a uint16 type.
 func ToUint16E(i any) (uint16, error) {
-	return toUnsignedNumberE[uint16](i, parseUint[uint16])
+	return toUnsignedNumberE[uint16](i, parseUint[uint16], config{})
 }
 
 // ToUint8E casts an interface to a uint type.
 func ToUint8E(i any) (uint8, error) {
-	return toUnsignedNumberE[uint8](i, parseUint[uint8])
+	return toUnsignedNumberE[uint8](i, parseUint[uint8], config{})
 }
 
 func trimZeroDecimal(s string) string {
@@ -6,7 +6,9 @@
 package cast_test
 
 import (
+	"database/sql"
 	"encoding/json"
+	"fmt"
 	"math"
 	"math/big"
 	"reflect"
@@ -231,10 +233,25 @@ func generateNumberTestCases(samples []any) []testCase {
 		{aliasEight, eight, false},
 		{MyString("8"), eight, false},
 		{MyBool(true), one, false},
+		{MyStatus(8), eight, false},
+
+		// database/sql
+		{sql.NullInt64{Int64: 8, Valid: true}, eight, false},
+		{sql.NullInt64{Int64: -8, Valid: true}, eightNegative, isUint},
+		{sql.NullInt16{Int16: 8}, zero, false},
+		{sql.NullString{String: "8", Valid: true}, eight, false},
+		{sql.NullString{String: "test", Valid: true}, zero, true},
+
+		// Complex numbers
+		{complex(8, 0), eight, false},
+		{complex64(complex(8, 0)), eight, false},
+		{complex(-8, 0), eightNegative, isUint},
 
 		// Failure cases
 		{"test", zero, true},
 		{testing.T{}, zero, true},
+		{complex(8, 1), zero, true},
+		{complex64(complex(8, -1)), zero, true},
 
 		{"10...17", zero, true},
 		{"10.foobar", zero, true},
@@ -390,6 +407,226 @@ func TestNumber(t *testing.T) {
 	}
 }
 
+func TestNumberStrict(t *testing.T) {
+	strict := cast.WithStrictNumbers()
+
+	t.Run("Int", func(t *testing.T) {
+		testCases := []testCase{
+			{"42", 42, false},
+			{"-42", -42, false},
+			{"0", 0, false},
+			{"-0", 0, false},
+			{"10.0", 10, false},
+			{json.Number("644"), 644, false},
+			{644, 644, false},
+
+			// Failure cases
+			{"0644", 0, true},
+			{"0x1f", 0, true},
+			{"0b101", 0, true},
+			{"0o17", 0, true},
+			{"-0644", 0, true},
+			{"1_000", 0, true},
+			{"10.5", 0, true},
+			{json.Number("0644"), 0, true},
+		}
+
+		runConversionTests(t, testCases, nil, toWith[int](strict))
+	})
+
+	t.Run("Uint", func(t *testing.T) {
+		testCases := []testCase{
+			{"+42", uint(42), false},
+
+			// Failure cases
+			{"00", uint(0), true},
+			{"0X1F", uint(0), true},
+			{"10.5", uint(0), true},
+		}
+
+		runConversionTests(t, testCases, nil, toWith[uint](strict))
+	})
+
+	t.Run("Float", func(t *testing.T) {
+		testCases := []testCase{
+			{"0.5", 0.5, false},
+			{"0e3", 0.0, false},
+			{"1.5e3", 1500.0, false},
+
+			// Failure cases
+			{"1_000.5", 0.0, true},
+			{"0x1p-2", 0.0, true},
+		}
+
+		runConversionTests(t, testCases, nil, toWith[float64](strict))
+	})
+
+	t.Run("Complex", func(t *testing.T) {
+		testCases := []testCase{
+			{"1.5+2i", complex(1.5, 2), false},
+			{"4", complex(4, 0), false},
+
+			// Failure cases
+			{"0x1p2", complex128(0), true},
+			{"1+0x1p2i", complex128(0), true},
+			{"1_000i", complex128(0), true},
+		}
+
+		runConversionTests(t, testCases, nil, toWith[complex128](strict))
+	})
+
+	t.Run("Complex64", func(t *testing.T) {
+		testCases := []testCase{
+			{"(1e-3-0.5i)", complex64(complex(1e-3, -0.5)), false},
+
+			// Failure cases
+			{"(01+2i)", complex64(0), true},
+		}
+
+		runConversionTests(t, testCases, nil, toWith[complex64](strict))
+	})
+}
+
+func TestNumberNonFinite(t *testing.T) {
+	nan := math.NaN()
+	inf := math.Inf(1)
+	finite := cast.WithFiniteFloats()
+
+	t.Run("Float", func(t *testing.T) {
+		testCases := []testCase{
+			{"NaN", "NaN", false},
+			{"nan", "NaN", false},
+			{"Inf", "+Inf", false},
+			{nan, "NaN", false},
+			{float32(inf), "+Inf", false},
+			{json.Number("+Inf"), "+Inf", false},
+		}
+
+		runConversionTests(t, testCases, nil, formatted(toWith[float64]()))
+	})
+
+	t.Run("Float32", func(t *testing.T) {
+		testCases := []testCase{
+			{"-infinity", "-Inf", false},
+		}
+
+		runConversionTests(t, testCases, nil, formatted(toWith[float32]()))
+	})
+
+	t.Run("FiniteFloat", func(t *testing.T) {
+		testCases := []testCase{
+			{1.5, "1.5", false},
+
+			// Failure cases
+			{"NaN", "", true},
+			{nan, "", true},
+			{json.Number("NaN"), "", true},
+		}
+
+		runConversionTests(t, testCases, nil, formatted(toWith[float64](finite)))
+	})
+
+	t.Run("FiniteFloat32", func(t *testing.T) {
+		testCases := []testCase{
+			{"1.5", "1.5", false},
+
+			// Failure cases
+			{"-Inf", "", true},
+			{-inf, "", true},
+		}
+
+		runConversionTests(t, testCases, nil, formatted(toWith[float32](finite)))
+	})
+
+	t.Run("Int", func(t *testing.T) {
+		testCases := []testCase{
+			// Failure cases
+			{nan, 0, true},
+			{inf, 0, true},
+			{float32(-inf), 0, true},
+			{complex(inf, 0), 0, true},
+			{"NaN", 0, true},
+		}
+
+		runConversionTests(t, testCases, nil, toWith[int]())
+	})
+
+	t.Run("Uint", func(t *testing.T) {
+		testCases := []testCase{
+			// Failure cases
+			{nan, uint(0), true},
+			{inf, uint(0), true},
+		}
+
+		runConversionTests(t, testCases, nil, toWith[uint]())
+	})
+
+	t.Run("Complex", func(t *testing.T) {
+		testCases := []testCase{
+			{"NaN+1i", "(NaN+1i)", false},
+		}
+
+		runConversionTests(t, testCases, nil, formatted(toWith[complex128]()))
+	})
+
+	t.Run("FiniteComplex", func(t *testing.T) {
+		testCases := []testCase{
+			{complex(1, 2), "(1+2i)", false},
+
+			// Failure cases
+			{"NaN+1i", "", true},
+			{"1-Infi", "", true},
+			{complex(1, inf), "", true},
+			{nan, "", true},
+		}
+
+		runConversionTests(t, testCases, nil, formatted(toWith[complex64](finite)))
+	})
+}
+
+func TestStringNonFinite(t *testing.T) {
+	c := qt.New(t)
+
+	for _, v := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		s, err := cast.ToStringE(v)
+		c.Assert(err, qt.IsNil)
+
+		f, err := cast.ToFloat64E(s)
+		c.Assert(err, qt.IsNil)
+
+		if math.IsNaN(v) {
+			c.Assert(s, qt.Equals, "NaN")
+			c.Assert(math.IsNaN(f), qt.IsTrue)
+		} else {
+			c.Assert(f, qt.Equals, v)
+		}
+	}
+
+	c.Assert(cast.ToString(math.Inf(1)), qt.Equals, "+Inf")
+	c.Assert(cast.ToString(float32(math.Inf(-1))), qt.Equals, "-Inf")
+}
+
+func toWithE[T cast.Basic](i any, opts ...cast.Option) (any, error) {
+	return cast.ToWithE[T](i, opts...)
+}
+
+// toWith returns a function converting values to T using the given options (e.g. for runConversionTests).
+func toWith[T cast.Basic](opts ...cast.Option) func(i any) (T, error) {
+	return func(i any) (T, error) {
+		return cast.ToWithE[T](i, opts...)
+	}
+}
+
+// formatted formats the results of to using [fmt.Sprint], so that non-finite values can be compared
+// (NaN is not equal to itself).
+func formatted[T any](to func(i any) (T, error)) func(i any) (string, error) {
+	return func(i any) (string, error) {
+		v, err := to(i)
+
+		return fmt.Sprint(v), err
+	}
+}
+
 func BenchmarkNumber(b *testing.B) {
 	type testCase struct {
 		name     string
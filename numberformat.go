@@ -0,0 +1,348 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	errInexactFloat    = errors.New("float cannot be represented exactly")
+	errInvalidGrouping = errors.New("invalid digit grouping")
+)
+
+// NumberFormat describes how numbers are written in strings,
+// allowing number conversions to parse localized input like "1.234,56" or "12 %".
+//
+// See [WithNumberFormat].
+type NumberFormat struct {
+	// GroupSeparators lists the characters accepted as digit group (thousands) separators.
+	//
+	// Separators are only accepted between groups of 3 digits of the integer part (the first group may be shorter),
+	// so "1.5" is rejected when using [NumberFormatGerman].
+	GroupSeparators string
+
+	// DecimalSeparator is the character separating the integer and fractional parts.
+	//
+	// Defaults to '.'.
+	DecimalSeparator rune
+
+	// Percent allows a percent sign after the number, dividing the value by 100 (e.g. "12.5%" is 0.125).
+	Percent bool
+
+	// Currency allows a currency symbol (any character in the Unicode currency symbol category, like $ or €)
+	// before or after the number.
+	Currency bool
+}
+
+// Number formats of common locales.
+var (
+	// NumberFormatEnglish formats numbers like 1,234.56 (used in English-speaking countries, China, Japan, ...).
+	NumberFormatEnglish = NumberFormat{GroupSeparators: ",", DecimalSeparator: '.'}
+
+	// NumberFormatGerman formats numbers like 1.234,56 (used in Germany, Italy, Spain, the Netherlands, ...).
+	NumberFormatGerman = NumberFormat{GroupSeparators: ".", DecimalSeparator: ','}
+
+	// NumberFormatFrench formats numbers like 1 234,56 (used in France, the Nordic countries, Eastern Europe, ...).
+	//
+	// Spaces, no-break spaces and narrow no-break spaces are all accepted as group separators.
+	NumberFormatFrench = NumberFormat{GroupSeparators: " \u00a0\u202f", DecimalSeparator: ','}
+
+	// NumberFormatSwiss formats numbers like 1'234.56.
+	NumberFormatSwiss = NumberFormat{GroupSeparators: "'\u2019", DecimalSeparator: '.'}
+)
+
+// numberString prepares a string for number parsing according to the configuration.
+func (c config) numberString(s string) (string, error) {
+	if c.numberFormat != nil {
+		return c.numberFormat.normalize(s)
+	}
+
+	return s, nil
+}
+
+// normalize rewrites s into the format accepted by [strconv].
+//
+// Misplaced group separators result in an error, other invalid input is left for the parser to reject.
+func (f NumberFormat) normalize(s string) (string, error) {
+	s = strings.TrimSpace(s)
+
+	sign, s := cutSign(s)
+
+	if f.Currency {
+		s = strings.TrimFunc(s, func(r rune) bool {
+			return unicode.Is(unicode.Sc, r) || unicode.IsSpace(r)
+		})
+
+		// The sign may also follow the currency symbol (e.g. "$-5")
+		if sign == "" {
+			sign, s = cutSign(s)
+		}
+	}
+
+	var percent bool
+
+	if f.Percent {
+		if v, ok := strings.CutSuffix(s, "%"); ok {
+			s = strings.TrimRightFunc(v, unicode.IsSpace)
+			percent = true
+		}
+	}
+
+	decimal := f.DecimalSeparator
+	if decimal == 0 {
+		decimal = '.'
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+
+	var fraction bool
+
+	// Group separators are only accepted between the groups of digits of the integer part:
+	// the first group has 1 to 3 digits, every other group exactly 3
+	var groups, digits int
+
+	integer := true
+
+	for _, r := range s {
+		separator := r != decimal && strings.ContainsRune(f.GroupSeparators, r)
+
+		if integer {
+			switch {
+			case isDigit(r):
+				digits++
+				b.WriteRune(r)
+
+				continue
+			case separator:
+				if digits == 0 || digits > 3 || groups > 0 && digits != 3 {
+					return "", errInvalidGrouping
+				}
+
+				groups++
+				digits = 0
+
+				continue
+			}
+
+			if groups > 0 && digits != 3 {
+				return "", errInvalidGrouping
+			}
+
+			integer = false
+		}
+
+		switch {
+		case r == decimal && !fraction:
+			b.WriteByte('.')
+			fraction = true
+		case separator:
+			return "", errInvalidGrouping
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	if integer && groups > 0 && digits != 3 {
+		return "", errInvalidGrouping
+	}
+
+	s = b.String()
+
+	if percent {
+		s = shiftDecimal(s, 2)
+	}
+
+	return sign + s, nil
+}
+
+// cutSign splits a leading sign from s.
+func cutSign(s string) (string, string) {
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		return s[:1], s[1:]
+	}
+
+	return "", s
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+// shiftDecimal divides the unsigned decimal number in s by 10^n by moving its decimal point to the left.
+//
+// Strings that are not decimal numbers are returned unchanged.
+func shiftDecimal(s string, n int) string {
+	mantissa, exponent := s, ""
+	if j := strings.IndexAny(s, "eE"); j >= 0 {
+		mantissa, exponent = s[:j], s[j:]
+	}
+
+	integer, fraction, _ := strings.Cut(mantissa, ".")
+	if integer == "" && fraction == "" || strings.Trim(integer+fraction, "0123456789") != "" {
+		return s
+	}
+
+	if len(integer) < n {
+		integer = strings.Repeat("0", n-len(integer)) + integer
+	}
+
+	fraction = integer[len(integer)-n:] + fraction
+
+	// Leading zeros would make integers parse as octal
+	integer = strings.TrimLeft(integer[:len(integer)-n], "0")
+	if integer == "" {
+		integer = "0"
+	}
+
+	return integer + "." + fraction + exponent
+}
+
+// formatNumber formats integers and floats according to the configuration.
+//
+// It returns false if i is not a number or no number formatting is configured.
+func (c config) formatNumber(i any) (string, bool, error) {
+	if c.numberFormat == nil && c.floatFormat == 0 && c.integerBase == 0 {
+		return "", false, nil
+	}
+
+	switch s := i.(type) {
+	case float64:
+		return c.formatFloat(i, s, 64)
+	case float32:
+		return c.formatFloat(i, float64(s), 32)
+	case int:
+		return c.formatInt(i, int64(s))
+	case int8:
+		return c.formatInt(i, int64(s))
+	case int16:
+		return c.formatInt(i, int64(s))
+	case int32:
+		return c.formatInt(i, int64(s))
+	case int64:
+		return c.formatInt(i, s)
+	case uint:
+		return c.formatUint(i, uint64(s))
+	case uint8:
+		return c.formatUint(i, uint64(s))
+	case uint16:
+		return c.formatUint(i, uint64(s))
+	case uint32:
+		return c.formatUint(i, uint64(s))
+	case uint64:
+		return c.formatUint(i, s)
+	}
+
+	return "", false, nil
+}
+
+// formatFloat formats v (the value of i, of the given bit size) using the configured format, precision and number format.
+//
+// Passing the original bit size keeps float32 values as short as possible (e.g. "8.31" instead of "8.3100004196167").
+func (c config) formatFloat(i any, v float64, bitSize int) (string, bool, error) {
+	format, prec := byte('f'), -1
+	if c.floatFormat != 0 {
+		format, prec = c.floatFormat, c.floatPrec
+	}
+
+	s := strconv.FormatFloat(v, format, prec, bitSize)
+
+	if c.exactFloats {
+		r, err := strconv.ParseFloat(s, bitSize)
+		if err != nil || r != v && !(math.IsNaN(r) && math.IsNaN(v)) {
+			return "", true, fmt.Errorf(errorMsgWith, i, i, "", errInexactFloat)
+		}
+	}
+
+	if c.numberFormat != nil {
+		s = c.numberFormat.format(s)
+	}
+
+	return s, true, nil
+}
+
+// formatInt formats the signed integer v (the value of i) using the configured base and number format.
+func (c config) formatInt(i any, v int64) (string, bool, error) {
+	base, err := c.base(i)
+	if err != nil {
+		return "", true, err
+	}
+
+	return c.formatDigits(strconv.FormatInt(v, base), base), true, nil
+}
+
+// formatUint formats the unsigned integer v (the value of i) using the configured base and number format.
+func (c config) formatUint(i any, v uint64) (string, bool, error) {
+	base, err := c.base(i)
+	if err != nil {
+		return "", true, err
+	}
+
+	return c.formatDigits(strconv.FormatUint(v, base), base), true, nil
+}
+
+// base returns the configured integer base for formatting i.
+func (c config) base(i any) (int, error) {
+	if c.integerBase == 0 {
+		return 10, nil
+	}
+
+	if c.integerBase < 2 || c.integerBase > 36 {
+		return 0, fmt.Errorf(errorMsgWith, i, i, "", fmt.Errorf("invalid integer base %d", c.integerBase))
+	}
+
+	return c.integerBase, nil
+}
+
+// formatDigits applies the number format to a formatted integer (in base 10 only).
+func (c config) formatDigits(s string, base int) string {
+	if base != 10 || c.numberFormat == nil {
+		return s
+	}
+
+	return c.numberFormat.format(s)
+}
+
+// format rewrites a number formatted by [strconv] to use the separators of the number format.
+func (f NumberFormat) format(s string) string {
+	sign, s := cutSign(s)
+
+	digits := strings.IndexFunc(s, func(r rune) bool { return !isDigit(r) })
+	if digits < 0 {
+		digits = len(s)
+	}
+
+	var b strings.Builder
+	b.Grow(len(s) * 2)
+
+	b.WriteString(sign)
+
+	group, _ := utf8.DecodeRuneInString(f.GroupSeparators)
+
+	for j := 0; j < digits; j++ {
+		if j > 0 && (digits-j)%3 == 0 && f.GroupSeparators != "" {
+			b.WriteRune(group)
+		}
+
+		b.WriteByte(s[j])
+	}
+
+	rest := s[digits:]
+	if f.DecimalSeparator != 0 {
+		rest = strings.Replace(rest, ".", string(f.DecimalSeparator), 1)
+	}
+
+	b.WriteString(rest)
+
+	return b.String()
+}
@@ -0,0 +1,215 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"github.com/spf13/cast"
+)
+
+func TestNumberFormat(t *testing.T) {
+	money := cast.NumberFormatEnglish
+	money.Currency = true
+
+	percent := cast.NumberFormatGerman
+	percent.Percent = true
+
+	testCases := []struct {
+		input       any
+		format      cast.NumberFormat
+		expected    float64
+		expectError bool
+	}{
+		{"1,234.56", cast.NumberFormatEnglish, 1234.56, false},
+		{"1,234,567", cast.NumberFormatEnglish, 1234567, false},
+		{"-1,234", cast.NumberFormatEnglish, -1234, false},
+		{"1234.5", cast.NumberFormatEnglish, 1234.5, false},
+		{" 1,234 ", cast.NumberFormatEnglish, 1234, false},
+		{json.Number("1,234"), cast.NumberFormatEnglish, 1234, false},
+		{"1.234,56", cast.NumberFormatGerman, 1234.56, false},
+		{"1.234.567", cast.NumberFormatGerman, 1234567, false},
+		{"0,5", cast.NumberFormatGerman, 0.5, false},
+		{"1 234,56", cast.NumberFormatFrench, 1234.56, false},
+		{"1 234,56", cast.NumberFormatFrench, 1234.56, false},
+		{"1 234", cast.NumberFormatFrench, 1234, false},
+		{"1'234.56", cast.NumberFormatSwiss, 1234.56, false},
+		{"1.5e3", cast.NumberFormatEnglish, 1500, false},
+		{MyString("1,5"), cast.NumberFormat{DecimalSeparator: ','}, 1.5, false},
+
+		// Currency
+		{"$1,234.56", money, 1234.56, false},
+		{"-$5", money, -5, false},
+		{"$-5", money, -5, false},
+		{"1,234 €", money, 1234, false},
+		{"£ 12", money, 12, false},
+
+		// Percent
+		{"12%", percent, 0.12, false},
+		{"12,5 %", percent, 0.125, false},
+		{"-5%", percent, -0.05, false},
+		{"1.234%", percent, 12.34, false},
+		{"0,5%", percent, 0.005, false},
+		{"42", percent, 42, false},
+
+		// Failure cases
+		{"1,2,3", cast.NumberFormatGerman, 0, true},
+		{"1.5", cast.NumberFormatGerman, 0, true},
+		{"1.2.3,5", cast.NumberFormatGerman, 0, true},
+		{"1.2345", cast.NumberFormatGerman, 0, true},
+		{"1234.567", cast.NumberFormatGerman, 0, true},
+		{".234", cast.NumberFormatGerman, 0, true},
+		{"1.234.", cast.NumberFormatGerman, 0, true},
+		{"1,234,56", cast.NumberFormatEnglish, 0, true},
+		{"1.5,2", cast.NumberFormatEnglish, 0, true},
+		{"1,,234", cast.NumberFormatEnglish, 0, true},
+		{",123", cast.NumberFormatEnglish, 0, true},
+		{"1.234,5", cast.NumberFormatEnglish, 0, true},
+		{"1,234.5", cast.NumberFormatGerman, 0, true},
+		{"$5", cast.NumberFormatEnglish, 0, true},
+		{"5%", cast.NumberFormatEnglish, 0, true},
+		{"%", percent, 0, true},
+		{"$", money, 0, true},
+	}
+
+	for _, testCase := range testCases {
+		// TODO: remove after minimum Go version is >=1.22
+		testCase := testCase
+
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			c := qt.New(t)
+
+			v, err := cast.ToNumberWithE[float64](testCase.input, cast.WithNumberFormat(testCase.format))
+			if testCase.expectError {
+				c.Assert(err, qt.IsNotNil)
+			} else {
+				c.Assert(err, qt.IsNil)
+				c.Assert(v, qt.Equals, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestNumberFormatInteger(t *testing.T) {
+	c := qt.New(t)
+
+	format := cast.NumberFormatGerman
+	format.Percent = true
+
+	v, err := cast.ToWithE[int]("1.234.567", cast.WithNumberFormat(format))
+	c.Assert(err, qt.IsNil)
+	c.Assert(v, qt.Equals, 1234567)
+
+	u, err := cast.ToNumberWithE[uint16]("12.345,9", cast.WithNumberFormat(format))
+	c.Assert(err, qt.IsNil)
+	c.Assert(u, qt.Equals, uint16(12345))
+
+	// Leading zeros must not turn the value into an octal number
+	v, err = cast.ToWithE[int]("1.234%", cast.WithNumberFormat(format))
+	c.Assert(err, qt.IsNil)
+	c.Assert(v, qt.Equals, 12)
+
+	_, err = cast.ToNumberWithE[uint]("-1.234", cast.WithNumberFormat(format))
+	c.Assert(err, qt.IsNotNil)
+
+	s, err := cast.ToSliceOfE[int]([]string{"1.000", "2.500"}, cast.WithNumberFormat(format))
+	c.Assert(err, qt.IsNil)
+	c.Assert(s, qt.DeepEquals, []int{1000, 2500})
+
+	m, err := cast.ToMapE[string, float64](map[string]any{"a": "1.000,5"}, cast.WithNumberFormat(format))
+	c.Assert(err, qt.IsNil)
+	c.Assert(m, qt.DeepEquals, map[string]float64{"a": 1000.5})
+
+	// Without the option, the Go syntax is used
+	_, err = cast.ToIntE("1.234.567")
+	c.Assert(err, qt.IsNotNil)
+}
+
+func TestStringNumberFormatting(t *testing.T) {
+	testCases := []struct {
+		input       any
+		opts        []cast.Option
+		expected    string
+		expectError bool
+	}{
+		// Defaults are unchanged
+		{1e21, nil, "1000000000000000000000", false},
+		{float32(8.31), nil, "8.31", false},
+		{255, nil, "255", false},
+
+		{1e21, []cast.Option{cast.WithFloatFormat('e', -1)}, "1e+21", false},
+		{1e21, []cast.Option{cast.WithFloatFormat('g', -1)}, "1e+21", false},
+		{3.14159, []cast.Option{cast.WithFloatFormat('f', 2)}, "3.14", false},
+		{float32(8.31), []cast.Option{cast.WithFloatFormat('e', -1)}, "8.31e+00", false},
+		{float32(0.1), []cast.Option{cast.WithFloatFormat('g', -1)}, "0.1", false},
+
+		{255, []cast.Option{cast.WithIntegerBase(16)}, "ff", false},
+		{int8(-5), []cast.Option{cast.WithIntegerBase(2)}, "-101", false},
+		{uint64(1 << 63), []cast.Option{cast.WithIntegerBase(36)}, "1y2p0ij32e8e8", false},
+
+		{1234567, []cast.Option{cast.WithNumberFormat(cast.NumberFormatEnglish)}, "1,234,567", false},
+		{-1234, []cast.Option{cast.WithNumberFormat(cast.NumberFormatEnglish)}, "-1,234", false},
+		{123, []cast.Option{cast.WithNumberFormat(cast.NumberFormatEnglish)}, "123", false},
+		{uint(1000), []cast.Option{cast.WithNumberFormat(cast.NumberFormatSwiss)}, "1'000", false},
+		{1234.5, []cast.Option{cast.WithNumberFormat(cast.NumberFormatGerman)}, "1.234,5", false},
+		{1234.5, []cast.Option{cast.WithNumberFormat(cast.NumberFormatFrench), cast.WithFloatFormat('f', 2)}, "1 234,50", false},
+		{1234.5, []cast.Option{cast.WithNumberFormat(cast.NumberFormat{DecimalSeparator: ','})}, "1234,5", false},
+		{65535, []cast.Option{cast.WithNumberFormat(cast.NumberFormatEnglish), cast.WithIntegerBase(16)}, "ffff", false},
+
+		// Other values are not affected
+		{json.Number("1234"), []cast.Option{cast.WithNumberFormat(cast.NumberFormatEnglish)}, "1234", false},
+		{"1234", []cast.Option{cast.WithIntegerBase(16)}, "1234", false},
+
+		// Failure cases
+		{10, []cast.Option{cast.WithIntegerBase(1)}, "", true},
+		{10, []cast.Option{cast.WithIntegerBase(37)}, "", true},
+	}
+
+	for _, testCase := range testCases {
+		// TODO: remove after minimum Go version is >=1.22
+		testCase := testCase
+
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			c := qt.New(t)
+
+			v, err := cast.ToStringWithE(testCase.input, testCase.opts...)
+			if testCase.expectError {
+				c.Assert(err, qt.IsNotNil)
+			} else {
+				c.Assert(err, qt.IsNil)
+				c.Assert(v, qt.Equals, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestNumberFormatRoundTrip(t *testing.T) {
+	c := qt.New(t)
+
+	for _, format := range []cast.NumberFormat{
+		cast.NumberFormatEnglish,
+		cast.NumberFormatGerman,
+		cast.NumberFormatFrench,
+		cast.NumberFormatSwiss,
+	} {
+		s, err := cast.ToStringWithE(-1234567.25, cast.WithNumberFormat(format))
+		c.Assert(err, qt.IsNil)
+
+		_, err = cast.ToFloat64E(s)
+		c.Assert(err, qt.IsNotNil)
+
+		v, err := cast.ToNumberWithE[float64](s, cast.WithNumberFormat(format))
+		c.Assert(err, qt.IsNil)
+		c.Assert(v, qt.Equals, -1234567.25)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast
+
+import (
+	"reflect"
+)
+
+// ToOptionalE casts any value to a pointer to a [Basic] type.
+//
+// Unlike [ToE], it distinguishes absent values from values explicitly set to the zero value:
+// nil, nil pointers, empty strings and invalid (NULL) [database/sql/driver.Valuer] values result in a nil pointer.
+func ToOptionalE[T Basic](i any) (*T, error) {
+	return toOptionalE(i, ToE[T])
+}
+
+func toOptionalE[T Basic](i any, toE func(any) (T, error)) (*T, error) {
+	i, _ = indirect(i)
+
+	if v, ok := indirectValuer(i); ok {
+		i = v
+	}
+
+	if isAbsent(i) {
+		return nil, nil
+	}
+
+	v, err := toE(i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+// toOptionalFunc returns a function casting values to a pointer to T (see [ToOptionalE]) using the given options.
+func toOptionalFunc[T Basic](opts []Option) func(any) (*T, error) {
+	toE := toFunc[T](opts)
+
+	return func(i any) (*T, error) {
+		return toOptionalE(i, toE)
+	}
+}
+
+// ToOptional casts any value to a pointer to a [Basic] type.
+//
+// See [ToOptionalE] for details.
+func ToOptional[T Basic](i any) *T {
+	v, _ := ToOptionalE[T](i)
+
+	return v
+}
+
+// ToOptionalSliceE casts any value to a []*T type, converting elements using [ToOptionalE] and the given options.
+func ToOptionalSliceE[T Basic](i any, opts ...Option) ([]*T, error) {
+	return toSliceEWith(i, toOptionalFunc[T](opts), opts...)
+}
+
+// ToOptionalMapE casts any value to a map[K]*V type, converting keys using [ToE] and values using [ToOptionalE]
+// (applying the given options to both).
+func ToOptionalMapE[K, V Basic](i any, opts ...Option) (map[K]*V, error) {
+	return toMapE(i, toFunc[K](opts), toOptionalFunc[V](opts), opts...)
+}
+
+// isAbsent reports whether a value (after indirection) represents the absence of a value.
+func isAbsent(i any) bool {
+	if i == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(i)
+
+	return v.Kind() == reflect.String && v.Len() == 0
+}
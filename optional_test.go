@@ -0,0 +1,83 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"github.com/spf13/cast"
+)
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
+
+func TestOptional(t *testing.T) {
+	var ptr *int
+
+	testCases := []testCase{
+		{8, ptrTo(8), false},
+		{"8", ptrTo(8), false},
+		{0, ptrTo(0), false},
+		{"0", ptrTo(0), false},
+		{false, ptrTo(0), false},
+		{ptrTo(8), ptrTo(8), false},
+		{sql.NullInt64{Int64: 8, Valid: true}, ptrTo(8), false},
+
+		// Absent values
+		{nil, (*int)(nil), false},
+		{ptr, (*int)(nil), false},
+		{"", (*int)(nil), false},
+		{json.Number(""), (*int)(nil), false},
+		{MyString(""), (*int)(nil), false},
+		{sql.NullInt64{Int64: 8}, (*int)(nil), false},
+
+		// Failure cases
+		{"test", (*int)(nil), true},
+		{testing.T{}, (*int)(nil), true},
+	}
+
+	runConversionTests(t, testCases, cast.ToOptional[int], cast.ToOptionalE[int])
+}
+
+func TestOptionalSlice(t *testing.T) {
+	c := qt.New(t)
+
+	v, err := cast.ToOptionalSliceE[bool]([]any{"true", nil, "", false})
+	c.Assert(err, qt.IsNil)
+	c.Assert(v, qt.DeepEquals, []*bool{ptrTo(true), nil, nil, ptrTo(false)})
+
+	_, err = cast.ToOptionalSliceE[bool]([]any{"true", "test"})
+	c.Assert(err, qt.IsNotNil)
+
+	// Options are applied to every element
+	n, err := cast.ToOptionalSliceE[int]([]any{" 1 ", nil}, cast.WithNormalization())
+	c.Assert(err, qt.IsNil)
+	c.Assert(n, qt.DeepEquals, []*int{ptrTo(1), nil})
+}
+
+func TestOptionalMap(t *testing.T) {
+	c := qt.New(t)
+
+	v, err := cast.ToOptionalMapE[string, int](map[string]any{"a": "1", "b": nil, "c": 0})
+	c.Assert(err, qt.IsNil)
+	c.Assert(v, qt.DeepEquals, map[string]*int{"a": ptrTo(1), "b": nil, "c": ptrTo(0)})
+
+	_, err = cast.ToOptionalMapE[string, int](map[string]any{"a": "test"})
+	c.Assert(err, qt.IsNotNil)
+
+	// Options are applied to every value
+	_, err = cast.ToOptionalMapE[string, int](map[string]any{"a": "0644"}, cast.WithStrictNumbers())
+	c.Assert(err, qt.IsNotNil)
+
+	v, err = cast.ToOptionalMapE[string, int](map[string]any{"a": "１"}, cast.WithNormalization())
+	c.Assert(err, qt.IsNil)
+	c.Assert(v, qt.DeepEquals, map[string]*int{"a": ptrTo(1)})
+}
@@ -0,0 +1,171 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast
+
+// Option configures the behavior of the cast functions accepting options.
+type Option func(*config)
+
+type config struct {
+	allErrors     bool
+	defaultOnNil  bool
+	runeText      bool
+	encoding      Encoding
+	regexpCache   *RegexpCache
+	numberFormat  *NumberFormat
+	floatFormat   byte
+	floatPrec     int
+	integerBase   int
+	strictString  bool
+	exactFloats   bool
+	strictNumbers bool
+	normalize     bool
+	finiteFloats  bool
+
+	// Set by AsE and Into only (see checkOverflow)
+	rejectOverflow bool
+}
+
+func newConfig(opts []Option) config {
+	var c config
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return c
+}
+
+// Options combines multiple options into a single one,
+// so that a set of options can be defined once and reused across conversions.
+func Options(opts ...Option) Option {
+	return func(c *config) {
+		for _, opt := range opts {
+			opt(c)
+		}
+	}
+}
+
+// WithAllErrors makes conversions of compound values (like maps and slices) continue after the first failing element
+// and report every failure instead.
+//
+// The individual errors are joined using [errors.Join].
+func WithAllErrors() Option {
+	return func(c *config) {
+		c.allErrors = true
+	}
+}
+
+// WithDefaultOnNil makes the default value functions (e.g. [ToOr]) return the default value for nil inputs
+// (nil, nil pointers and invalid (NULL) [database/sql/driver.Valuer] values) as well.
+//
+// By default, nil inputs are converted to the zero value and only conversion failures result in the default value.
+func WithDefaultOnNil() Option {
+	return func(c *config) {
+		c.defaultOnNil = true
+	}
+}
+
+// WithRuneText makes string conversions render rune (int32) and []rune ([]int32) values as text
+// instead of numbers (or failing).
+func WithRuneText() Option {
+	return func(c *config) {
+		c.runeText = true
+	}
+}
+
+// WithEncoding sets the encoding of binary data in strings:
+// [ToBytesWithE] decodes strings and [ToStringWithE] encodes byte slices using it.
+//
+// Without this option, strings hold raw bytes ([EncodingRaw]).
+func WithEncoding(enc Encoding) Option {
+	return func(c *config) {
+		c.encoding = enc
+	}
+}
+
+// WithRegexpCache makes regular expression conversions look up compiled patterns in (and add them to) cache.
+func WithRegexpCache(cache *RegexpCache) Option {
+	return func(c *config) {
+		c.regexpCache = cache
+	}
+}
+
+// WithNumberFormat makes number conversions parse strings (and [encoding/json.Number] values) written in format f
+// (e.g. [NumberFormatGerman]), instead of the Go syntax accepted by [strconv].
+//
+// [ToStringWithE] formats numbers using the decimal separator and the first group separator of f.
+func WithNumberFormat(f NumberFormat) Option {
+	return func(c *config) {
+		c.numberFormat = &f
+	}
+}
+
+// WithFloatFormat makes string conversions format floats using the given format and precision
+// (see [strconv.FormatFloat]), for example 'e' for scientific notation or 'f' with a fixed number of decimals.
+//
+// By default, floats are formatted using 'f' and the smallest precision that represents the value exactly.
+func WithFloatFormat(format byte, prec int) Option {
+	return func(c *config) {
+		c.floatFormat = format
+		c.floatPrec = prec
+	}
+}
+
+// WithIntegerBase makes string conversions format integers in the given base (between 2 and 36),
+// using lower-case letters for digit values >= 10 and no base prefix (see [strconv.FormatInt]).
+func WithIntegerBase(base int) Option {
+	return func(c *config) {
+		c.integerBase = base
+	}
+}
+
+// WithStrictString makes string conversions accept string-like scalars only:
+// strings, byte slices, [encoding/json.Number] values, numbers, bools, [html/template] strings and nil.
+//
+// Other values (like errors, [fmt.Stringer] implementations, structs and pointers) result in an error.
+// Named types of string-like kinds are converted by their underlying value, ignoring their String methods.
+func WithStrictString() Option {
+	return func(c *config) {
+		c.strictString = true
+	}
+}
+
+// WithExactFloats makes string conversions fail for floats that cannot be parsed back to the same value,
+// for example because of the precision set using [WithFloatFormat].
+func WithExactFloats() Option {
+	return func(c *config) {
+		c.exactFloats = true
+	}
+}
+
+// WithStrictNumbers makes number conversions accept base 10 strings only, rejecting base prefixes (like "0x1f"),
+// leading zeros (like "0644", which would otherwise be parsed as an octal number) and underscores.
+//
+// Strings with a fractional part are also rejected when converting to integers, instead of being truncated.
+func WithStrictNumbers() Option {
+	return func(c *config) {
+		c.strictNumbers = true
+	}
+}
+
+// WithNormalization makes conversions (other than string conversions) normalize string and
+// [encoding/json.Number] inputs before parsing them: white space is trimmed, no-break and zero width spaces are
+// removed, and fullwidth characters (like "４２") and decimal digits of other scripts are folded to ASCII.
+func WithNormalization() Option {
+	return func(c *config) {
+		c.normalize = true
+	}
+}
+
+// WithFiniteFloats makes number conversions reject non-finite values (NaN and positive or negative infinity),
+// including strings like "NaN" or "Inf" that are otherwise accepted by [strconv.ParseFloat].
+//
+// Converting non-finite values to integers always fails.
+func WithFiniteFloats() Option {
+	return func(c *config) {
+		c.finiteFloats = true
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// KeyValue is a single entry of an ordered map.
+type KeyValue[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// OrderedMap is implemented by map types preserving the insertion order of their keys.
+type OrderedMap interface {
+	Keys() []string
+	Get(key string) (any, bool)
+}
+
+// ToOrderedStringMapE casts any value to a []KeyValue[string, any] type, preserving the order of the keys.
+//
+// Ordered inputs are [OrderedMap] implementations, slices of structs with Key and Value fields (like yaml.MapSlice)
+// and JSON objects. The entries of other map types are sorted by key.
+func ToOrderedStringMapE(i any) ([]KeyValue[string, any], error) {
+	fn := func(i any) (any, error) { return i, nil }
+
+	return toOrderedMapE(i, ToStringE, fn)
+}
+
+func toOrderedMapE[K cmp.Ordered, V any](i any, keyFn func(any) (K, error), valFn func(any) (V, error)) ([]KeyValue[K, V], error) {
+	i, _ = indirect(i)
+
+	var s []KeyValue[K, V]
+
+	if i == nil {
+		return s, fmt.Errorf(errorMsg, i, i, s)
+	}
+
+	var entries []KeyValue[any, any]
+
+	switch v := i.(type) {
+	case []KeyValue[K, V]:
+		return append(s, v...), nil
+
+	case string:
+		var err error
+
+		entries, err = jsonStringToOrderedObject(v)
+		if err != nil {
+			return s, err
+		}
+
+	default:
+		var ok bool
+
+		entries, ok = orderedEntries(i)
+		if !ok {
+			m := map[K]V{}
+
+			errs, ok := convertReflectMap(m, i, keyFn, valFn, false)
+			if !ok {
+				return s, fmt.Errorf(errorMsg, i, i, s)
+			}
+
+			if err := wrapErrors(i, s, errs); err != nil {
+				return s, err
+			}
+
+			for key, value := range m {
+				s = append(s, KeyValue[K, V]{Key: key, Value: value})
+			}
+
+			slices.SortFunc(s, func(a, b KeyValue[K, V]) int {
+				return cmp.Compare(a.Key, b.Key)
+			})
+
+			return s, nil
+		}
+	}
+
+	// Keys may collide after conversion: keep the position of the first occurrence and the last value.
+	index := make(map[K]int, len(entries))
+
+	for _, e := range entries {
+		key, value, err := convertEntry(e.Key, e.Value, keyFn, valFn)
+		if err != nil {
+			return s, wrapErrors(i, s, []error{err})
+		}
+
+		if j, ok := index[key]; ok {
+			s[j].Value = value
+
+			continue
+		}
+
+		index[key] = len(s)
+		s = append(s, KeyValue[K, V]{Key: key, Value: value})
+	}
+
+	return s, nil
+}
+
+// orderedEntries returns the entries of ordered map values in order.
+//
+// Ordered map values are [OrderedMap] implementations and slices (or arrays) of structs with Key and Value fields.
+func orderedEntries(i any) ([]KeyValue[any, any], bool) {
+	if v, ok := i.(OrderedMap); ok {
+		keys := v.Keys()
+		entries := make([]KeyValue[any, any], 0, len(keys))
+
+		for _, k := range keys {
+			val, _ := v.Get(k)
+			entries = append(entries, KeyValue[any, any]{Key: k, Value: val})
+		}
+
+		return entries, true
+	}
+
+	if i == nil {
+		return nil, false
+	}
+
+	t := reflect.TypeOf(i)
+	if (t.Kind() != reflect.Slice && t.Kind() != reflect.Array) || !isKeyValueStruct(t.Elem()) {
+		return nil, false
+	}
+
+	v := reflect.ValueOf(i)
+	entries := make([]KeyValue[any, any], 0, v.Len())
+
+	for j := 0; j < v.Len(); j++ {
+		e := v.Index(j)
+
+		entries = append(entries, KeyValue[any, any]{
+			Key:   e.FieldByName("Key").Interface(),
+			Value: e.FieldByName("Value").Interface(),
+		})
+	}
+
+	return entries, true
+}
+
+func isKeyValueStruct(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+
+	key, ok := t.FieldByName("Key")
+	if !ok || !key.IsExported() {
+		return false
+	}
+
+	value, ok := t.FieldByName("Value")
+
+	return ok && value.IsExported()
+}
+
+// jsonStringToOrderedObject decodes a JSON object preserving the order of its keys.
+//
+// Only the top level object is ordered: nested objects are decoded into map[string]any.
+func jsonStringToOrderedObject(s string) ([]KeyValue[any, any], error) {
+	dec := json.NewDecoder(strings.NewReader(s))
+
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return nil, fmt.Errorf("unable to decode %q as a JSON object", s)
+	}
+
+	var entries []KeyValue[any, any]
+
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		var val any
+		if err := dec.Decode(&val); err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, KeyValue[any, any]{Key: key, Value: val})
+	}
+
+	// Closing delimiter
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("unable to decode %q as a JSON object: unexpected data after top-level value", s)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,89 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast_test
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"github.com/spf13/cast"
+)
+
+type orderedMap struct {
+	keys   []string
+	values map[string]any
+}
+
+func (m orderedMap) Keys() []string {
+	return m.keys
+}
+
+func (m orderedMap) Get(key string) (any, bool) {
+	v, ok := m.values[key]
+
+	return v, ok
+}
+
+type mapItem struct {
+	Key   any
+	Value any
+}
+
+func TestOrderedStringMap(t *testing.T) {
+	expected := []cast.KeyValue[string, any]{{"z", 1}, {"a", "two"}, {"m", true}}
+
+	testCases := []testCase{
+		{expected, expected, false},
+		{orderedMap{keys: []string{"z", "a", "m"}, values: map[string]any{"a": "two", "m": true, "z": 1}}, expected, false},
+		{[]mapItem{{"z", 1}, {"a", "two"}, {"m", true}}, expected, false},
+		{[]mapItem{{"z", 1}, {"a", "two"}, {"z", 3}}, []cast.KeyValue[string, any]{{"z", 3}, {"a", "two"}}, false},
+		{[]mapItem{{MyString("z"), 1}, {2, "two"}}, []cast.KeyValue[string, any]{{"z", 1}, {"2", "two"}}, false},
+		{`{"z": 1, "a": "two", "m": true}`, []cast.KeyValue[string, any]{{"z", float64(1)}, {"a", "two"}, {"m", true}}, false},
+		{map[string]any{"z": 1, "a": "two", "m": true}, []cast.KeyValue[string, any]{{"a", "two"}, {"m", true}, {"z", 1}}, false},
+
+		// Failure cases
+		{nil, []cast.KeyValue[string, any](nil), true},
+		{testing.T{}, []cast.KeyValue[string, any](nil), true},
+		{[]mapItem{{testing.T{}, 1}}, []cast.KeyValue[string, any](nil), true},
+		{`["z", "a"]`, []cast.KeyValue[string, any](nil), true},
+		{`{"z": 1} {}`, []cast.KeyValue[string, any](nil), true},
+		{"", []cast.KeyValue[string, any](nil), true},
+	}
+
+	for _, testCase := range testCases {
+		// TODO: remove after minimum Go version is >=1.22
+		testCase := testCase
+
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			c := qt.New(t)
+
+			v, err := cast.ToOrderedStringMapE(testCase.input)
+			if testCase.expectError {
+				c.Assert(err, qt.IsNotNil)
+			} else {
+				c.Assert(err, qt.IsNil)
+				c.Assert(v, qt.DeepEquals, testCase.expected)
+			}
+
+			c.Assert(cast.ToOrderedStringMap(testCase.input), qt.DeepEquals, v)
+		})
+	}
+}
+
+func TestStringMapOrderedInput(t *testing.T) {
+	c := qt.New(t)
+
+	v, err := cast.ToStringMapStringE(orderedMap{keys: []string{"z", "a"}, values: map[string]any{"a": 2, "z": 1}})
+	c.Assert(err, qt.IsNil)
+	c.Assert(v, qt.DeepEquals, map[string]string{"z": "1", "a": "2"})
+
+	v, err = cast.ToStringMapStringE([]mapItem{{"z", 1}, {"a", 2}})
+	c.Assert(err, qt.IsNil)
+	c.Assert(v, qt.DeepEquals, map[string]string{"z": "1", "a": "2"})
+}
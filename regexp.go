@@ -0,0 +1,212 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// RegexpCache caches compiled regular expressions by their source.
+//
+// The zero value is ready to use. A RegexpCache is safe for concurrent use.
+type RegexpCache struct {
+	m sync.Map
+}
+
+func (c *RegexpCache) compile(expr string) (*regexp.Regexp, error) {
+	if re, ok := c.m.Load(expr); ok {
+		return re.(*regexp.Regexp), nil
+	}
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	actual, _ := c.m.LoadOrStore(expr, re)
+
+	return actual.(*regexp.Regexp), nil
+}
+
+// ToRegexpE casts any value to a [*regexp.Regexp] type.
+//
+// Regular expressions are returned as is, anything else is converted using [ToStringE] and compiled.
+func ToRegexpE(i any) (*regexp.Regexp, error) {
+	return toRegexpE(i, config{})
+}
+
+// ToRegexpWithE casts any value to a [*regexp.Regexp] type using the given options (e.g. [WithRegexpCache]).
+func ToRegexpWithE(i any, opts ...Option) (*regexp.Regexp, error) {
+	return toRegexpE(i, newConfig(opts))
+}
+
+func toRegexpE(i any, c config) (*regexp.Regexp, error) {
+	if re, ok := i.(*regexp.Regexp); ok {
+		return re, nil
+	}
+
+	i, _ = indirect(i)
+
+	if i == nil {
+		return nil, nil
+	}
+
+	s, err := ToStringE(i)
+	if err != nil {
+		return nil, fmt.Errorf(errorMsgWith, i, i, &regexp.Regexp{}, err)
+	}
+
+	var re *regexp.Regexp
+
+	if c.regexpCache != nil {
+		re, err = c.regexpCache.compile(s)
+	} else {
+		re, err = regexp.Compile(s)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf(errorMsgWith, i, i, re, err)
+	}
+
+	return re, nil
+}
+
+// ToGlobE casts any value to a [*regexp.Regexp] type matching the glob pattern (using the syntax of [path.Match]).
+//
+// Like in [path.Match], '*' and '?' do not match '/'.
+// Regular expressions are returned as is, anything else is converted using [ToStringE] and compiled.
+func ToGlobE(i any) (*regexp.Regexp, error) {
+	return toGlobE(i, config{})
+}
+
+// ToGlobWithE casts any value to a [*regexp.Regexp] type matching the glob pattern using the given options (e.g. [WithRegexpCache]).
+//
+// See [ToGlobE] for details.
+func ToGlobWithE(i any, opts ...Option) (*regexp.Regexp, error) {
+	return toGlobE(i, newConfig(opts))
+}
+
+func toGlobE(i any, c config) (*regexp.Regexp, error) {
+	if re, ok := i.(*regexp.Regexp); ok {
+		return re, nil
+	}
+
+	i, _ = indirect(i)
+
+	if i == nil {
+		return nil, nil
+	}
+
+	s, err := ToStringE(i)
+	if err != nil {
+		return nil, fmt.Errorf(errorMsgWith, i, i, &regexp.Regexp{}, err)
+	}
+
+	expr, err := globToRegexp(s)
+	if err != nil {
+		return nil, fmt.Errorf(errorMsgWith, i, i, &regexp.Regexp{}, err)
+	}
+
+	return toRegexpE(expr, c)
+}
+
+// globToRegexp translates a glob pattern (using the syntax of [path.Match]) to an equivalent regular expression.
+func globToRegexp(pattern string) (string, error) {
+	// Reject malformed patterns (the whole pattern is validated, even if it doesn't match)
+	if _, err := path.Match(pattern, ""); err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+
+	b.WriteByte('^')
+
+	for j := 0; j < len(pattern); j++ {
+		switch ch := pattern[j]; ch {
+		case '*':
+			b.WriteString(`[^/]*`)
+		case '?':
+			b.WriteString(`[^/]`)
+		case '\\':
+			j++
+			b.WriteString(regexp.QuoteMeta(pattern[j : j+1]))
+		case '[':
+			b.WriteByte('[')
+
+			if j+1 < len(pattern) && pattern[j+1] == '^' {
+				b.WriteByte('^')
+				j++
+			}
+
+			for j++; pattern[j] != ']'; j++ {
+				switch ch := pattern[j]; {
+				case ch == '\\':
+					j++
+					writeClassChar(&b, pattern[j])
+				case ch == '-':
+					b.WriteByte('-')
+				default:
+					writeClassChar(&b, ch)
+				}
+			}
+
+			b.WriteByte(']')
+		default:
+			b.WriteString(regexp.QuoteMeta(pattern[j : j+1]))
+		}
+	}
+
+	b.WriteByte('$')
+
+	return b.String(), nil
+}
+
+// writeClassChar writes a literal character of a character class, escaping punctuation.
+func writeClassChar(b *strings.Builder, ch byte) {
+	if ch < utf8.RuneSelf && !('0' <= ch && ch <= '9' || 'a' <= ch && ch <= 'z' || 'A' <= ch && ch <= 'Z') {
+		b.WriteByte('\\')
+	}
+
+	b.WriteByte(ch)
+}
+
+// ToRegexpSliceE casts any value to a []*regexp.Regexp type.
+//
+// Inputs are handled like in [ToStringSliceE]: strings are split into fields,
+// slices and arrays are converted element by element and any other value becomes a single element slice.
+func ToRegexpSliceE(i any) ([]*regexp.Regexp, error) {
+	return toStringlikeSliceE(i, ToRegexpE)
+}
+
+// ToRegexpSliceWithE casts any value to a []*regexp.Regexp type using the given options (e.g. [WithRegexpCache]).
+//
+// See [ToRegexpSliceE] for details.
+func ToRegexpSliceWithE(i any, opts ...Option) ([]*regexp.Regexp, error) {
+	c := newConfig(opts)
+
+	return toStringlikeSliceE(i, func(i any) (*regexp.Regexp, error) { return toRegexpE(i, c) })
+}
+
+// ToGlobSliceE casts any value to a []*regexp.Regexp type matching glob patterns.
+//
+// Inputs are handled like in [ToRegexpSliceE], patterns are converted using [ToGlobE].
+func ToGlobSliceE(i any) ([]*regexp.Regexp, error) {
+	return toStringlikeSliceE(i, ToGlobE)
+}
+
+// ToGlobSliceWithE casts any value to a []*regexp.Regexp type matching glob patterns using the given options (e.g. [WithRegexpCache]).
+//
+// See [ToGlobSliceE] for details.
+func ToGlobSliceWithE(i any, opts ...Option) ([]*regexp.Regexp, error) {
+	c := newConfig(opts)
+
+	return toStringlikeSliceE(i, func(i any) (*regexp.Regexp, error) { return toGlobE(i, c) })
+}
@@ -0,0 +1,186 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast_test
+
+import (
+	"path"
+	"regexp"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"github.com/spf13/cast"
+)
+
+func TestRegexp(t *testing.T) {
+	re := regexp.MustCompile(`^foo\d+$`)
+
+	testCases := []testCase{
+		{`^foo\d+$`, `^foo\d+$`, false},
+		{[]byte(`^foo\d+$`), `^foo\d+$`, false},
+		{MyString(`^foo\d+$`), `^foo\d+$`, false},
+		{re, `^foo\d+$`, false},
+		{42, `42`, false},
+
+		// Failure cases
+		{`^foo(\d+$`, "", true},
+		{testing.T{}, "", true},
+	}
+
+	// Compare the source of the compiled expressions
+	source := func(re *regexp.Regexp) string {
+		if re == nil {
+			return ""
+		}
+
+		return re.String()
+	}
+
+	runConversionTests(t, testCases,
+		func(i any) string { return source(cast.ToRegexp(i)) },
+		func(i any) (string, error) {
+			re, err := cast.ToRegexpE(i)
+
+			return source(re), err
+		},
+	)
+
+	c := qt.New(t)
+
+	v, err := cast.ToRegexpE(re)
+	c.Assert(err, qt.IsNil)
+	c.Assert(v, qt.Equals, re)
+
+	v, err = cast.ToRegexpE(nil)
+	c.Assert(err, qt.IsNil)
+	c.Assert(v, qt.IsNil)
+}
+
+func TestRegexpSlice(t *testing.T) {
+	c := qt.New(t)
+
+	sources := func(res []*regexp.Regexp) []string {
+		var s []string
+		for _, re := range res {
+			s = append(s, re.String())
+		}
+
+		return s
+	}
+
+	testCases := []struct {
+		input    any
+		expected []string
+	}{
+		{`^foo$ ^bar$`, []string{`^foo$`, `^bar$`}},
+		{[]string{`^foo$`, `^bar$`}, []string{`^foo$`, `^bar$`}},
+		{[]any{`^foo$`, regexp.MustCompile(`^bar$`)}, []string{`^foo$`, `^bar$`}},
+		{regexp.MustCompile(`^foo$`), []string{`^foo$`}},
+	}
+
+	for _, testCase := range testCases {
+		// The behavior matches ToStringSliceE
+		c.Assert(sources(cast.ToRegexpSlice(testCase.input)), qt.DeepEquals, cast.ToStringSlice(testCase.input))
+
+		v, err := cast.ToRegexpSliceE(testCase.input)
+		c.Assert(err, qt.IsNil)
+		c.Assert(sources(v), qt.DeepEquals, testCase.expected)
+	}
+
+	// Unlike in ToStringSliceE, pointers to strings are split into fields
+	v, err := cast.ToRegexpSliceE(ptrTo(`^foo$ ^bar$`))
+	c.Assert(err, qt.IsNil)
+	c.Assert(sources(v), qt.DeepEquals, []string{`^foo$`, `^bar$`})
+
+	_, err = cast.ToRegexpSliceE([]string{`^foo$`, `^bar(`})
+	c.Assert(err, qt.IsNotNil)
+
+	_, err = cast.ToRegexpSliceE(nil)
+	c.Assert(err, qt.IsNotNil)
+}
+
+func TestRegexpCache(t *testing.T) {
+	c := qt.New(t)
+
+	var cache cast.RegexpCache
+
+	re1, err := cast.ToRegexpWithE(`^foo$`, cast.WithRegexpCache(&cache))
+	c.Assert(err, qt.IsNil)
+
+	re2, err := cast.ToRegexpWithE([]byte(`^foo$`), cast.WithRegexpCache(&cache))
+	c.Assert(err, qt.IsNil)
+	c.Assert(re2, qt.Equals, re1)
+
+	res, err := cast.ToRegexpSliceWithE(`^foo$ ^bar$`, cast.WithRegexpCache(&cache))
+	c.Assert(err, qt.IsNil)
+	c.Assert(res[0], qt.Equals, re1)
+
+	re3, err := cast.ToRegexpE(`^foo$`)
+	c.Assert(err, qt.IsNil)
+	c.Assert(re3, qt.Not(qt.Equals), re1)
+
+	_, err = cast.ToRegexpWithE(`^foo(`, cast.WithRegexpCache(&cache))
+	c.Assert(err, qt.IsNotNil)
+}
+
+func TestGlob(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		name    string
+	}{
+		{"*.go", "cast.go"},
+		{"*.go", "internal/time.go"},
+		{"*.go", "cast.gox"},
+		{"cast_?.go", "cast_a.go"},
+		{"cast_?.go", "cast_/.go"},
+		{"[a-c]*", "cast"},
+		{"[a-c]*", "time"},
+		{"[^a-c]*", "time"},
+		{"[\\]]", "]"},
+		{"a.b", "a.b"},
+		{"a.b", "axb"},
+		{"(x)+", "(x)+"},
+		{"\\*", "*"},
+		{"\\*", "x"},
+		{"données/*", "données/été"},
+	}
+
+	c := qt.New(t)
+
+	for _, testCase := range testCases {
+		expected, err := path.Match(testCase.pattern, testCase.name)
+		c.Assert(err, qt.IsNil)
+
+		re, err := cast.ToGlobE(testCase.pattern)
+		c.Assert(err, qt.IsNil)
+		c.Assert(re.MatchString(testCase.name), qt.Equals, expected, qt.Commentf("%s matching %s", re, testCase.name))
+	}
+
+	for _, pattern := range []string{"[", "[]", "a\\", "[a-"} {
+		_, err := cast.ToGlobE(pattern)
+		c.Assert(err, qt.IsNotNil, qt.Commentf(pattern))
+	}
+
+	re := regexp.MustCompile(`^foo$`)
+
+	v, err := cast.ToGlobE(re)
+	c.Assert(err, qt.IsNil)
+	c.Assert(v, qt.Equals, re)
+
+	res, err := cast.ToGlobSliceE("*.go *.md")
+	c.Assert(err, qt.IsNil)
+	c.Assert(res, qt.HasLen, 2)
+	c.Assert(res[1].MatchString("README.md"), qt.IsTrue)
+
+	var cache cast.RegexpCache
+
+	re1, err := cast.ToGlobWithE("*.go", cast.WithRegexpCache(&cache))
+	c.Assert(err, qt.IsNil)
+
+	res, err = cast.ToGlobSliceWithE([]string{"*.go"}, cast.WithRegexpCache(&cache))
+	c.Assert(err, qt.IsNil)
+	c.Assert(res[0], qt.Equals, re1)
+}
@@ -0,0 +1,115 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+var (
+	errInvalidCodePoint = errors.New("invalid unicode code point")
+	errInvalidUTF8      = errors.New("invalid UTF-8 encoding")
+	errNotSingleRune    = errors.New("string must contain exactly one character")
+)
+
+// ToRuneE casts any value to a rune type.
+//
+// Strings (and byte slices) must contain exactly one valid UTF-8 encoded character.
+// Integers (and [json.Number] values) are interpreted as Unicode code points.
+func ToRuneE(i any) (rune, error) {
+	i, _ = indirect(i)
+
+	switch s := i.(type) {
+	case rune:
+		return validRune(i, s)
+	case string:
+		return stringToRune(i, s)
+	case []byte:
+		return stringToRune(i, string(s))
+	case json.Number:
+		v, err := s.Int64()
+		if err != nil {
+			return 0, fmt.Errorf(errorMsgWith, i, i, rune(0), err)
+		}
+
+		return validRune(i, v)
+	case int, int8, int16, int64, uint, uint8, uint16, uint32, uint64:
+		v, _ := toNumber[int64](s)
+
+		return validRune(i, v)
+	case nil:
+		return 0, nil
+	default:
+		if i, ok := resolveAlias(i); ok {
+			return ToRuneE(i)
+		}
+
+		if i, ok := indirectValuer(i); ok {
+			return ToRuneE(i)
+		}
+
+		return 0, fmt.Errorf(errorMsg, i, i, rune(0))
+	}
+}
+
+// ToRuneSliceE casts any value to a []rune type.
+//
+// Strings (and byte slices) are split into characters and must be valid UTF-8.
+// The elements of other slices are converted using [ToRuneE].
+func ToRuneSliceE(i any) ([]rune, error) {
+	i, _ = indirect(i)
+
+	switch v := i.(type) {
+	case []rune:
+		return v, nil
+	case string:
+		return stringToRunes(i, v)
+	case []byte:
+		return stringToRunes(i, string(v))
+	}
+
+	if v, ok := resolveAlias(i); ok {
+		return ToRuneSliceE(v)
+	}
+
+	return toSliceEWith(i, ToRuneE)
+}
+
+func validRune[T rune | int64](i any, v T) (rune, error) {
+	if int64(v) != int64(rune(v)) || !utf8.ValidRune(rune(v)) {
+		return 0, fmt.Errorf(errorMsgWith, i, i, rune(0), errInvalidCodePoint)
+	}
+
+	return rune(v), nil
+}
+
+func stringToRune(i any, s string) (rune, error) {
+	r, size := utf8.DecodeRuneInString(s)
+
+	switch {
+	case r == utf8.RuneError && size <= 1:
+		if size == 0 {
+			return 0, fmt.Errorf(errorMsgWith, i, i, rune(0), errNotSingleRune)
+		}
+
+		return 0, fmt.Errorf(errorMsgWith, i, i, rune(0), errInvalidUTF8)
+	case size != len(s):
+		return 0, fmt.Errorf(errorMsgWith, i, i, rune(0), errNotSingleRune)
+	}
+
+	return r, nil
+}
+
+func stringToRunes(i any, s string) ([]rune, error) {
+	if !utf8.ValidString(s) {
+		return nil, fmt.Errorf(errorMsgWith, i, i, []rune{}, errInvalidUTF8)
+	}
+
+	return []rune(s), nil
+}
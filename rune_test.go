@@ -0,0 +1,91 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"github.com/spf13/cast"
+)
+
+func TestRune(t *testing.T) {
+	testCases := []testCase{
+		{'a', 'a', false},
+		{"a", 'a', false},
+		{"é", 'é', false},
+		{"世", '世', false},
+		{[]byte("a"), 'a', false},
+		{97, 'a', false},
+		{uint8(97), 'a', false},
+		{json.Number("97"), 'a', false},
+		{MyString("a"), 'a', false},
+		{nil, rune(0), false},
+		{ptrTo("a"), 'a', false},
+		{ptrTo(97), 'a', false},
+
+		// Failure cases
+		{"", rune(0), true},
+		{ptrTo("ab"), rune(0), true},
+		{"ab", rune(0), true},
+		{"\xff", rune(0), true},
+		{-1, rune(0), true},
+		{0xD800, rune(0), true},
+		{int64(1 << 40), rune(0), true},
+		{json.Number("9.7"), rune(0), true},
+		{97.0, rune(0), true},
+		{testing.T{}, rune(0), true},
+	}
+
+	runConversionTests(t, testCases, cast.ToRune, cast.ToRuneE)
+}
+
+func TestRuneSlice(t *testing.T) {
+	testCases := []testCase{
+		{[]rune("héllo"), []rune("héllo"), false},
+		{"héllo", []rune("héllo"), false},
+		{[]byte("héllo"), []rune("héllo"), false},
+		{MyString("héllo"), []rune("héllo"), false},
+		{[]string{"h", "é"}, []rune("hé"), false},
+		{[]any{"h", 233}, []rune("hé"), false},
+		{[]int{104, 233}, []rune("hé"), false},
+
+		// Failure cases
+		{nil, nil, true},
+		{"\xff", nil, true},
+		{[]string{"h", "el"}, nil, true},
+		{testing.T{}, nil, true},
+	}
+
+	runSliceTests(t, testCases, cast.ToRuneSlice, cast.ToRuneSliceE)
+}
+
+func TestStringRuneText(t *testing.T) {
+	c := qt.New(t)
+
+	v, err := cast.ToStringWithE('a', cast.WithRuneText())
+	c.Assert(err, qt.IsNil)
+	c.Assert(v, qt.Equals, "a")
+
+	v, err = cast.ToStringWithE([]rune("héllo"), cast.WithRuneText())
+	c.Assert(err, qt.IsNil)
+	c.Assert(v, qt.Equals, "héllo")
+
+	r := 'a'
+	v, err = cast.ToStringWithE(&r, cast.WithRuneText())
+	c.Assert(err, qt.IsNil)
+	c.Assert(v, qt.Equals, "a")
+
+	// Default behavior is unchanged
+	v, err = cast.ToStringWithE('a')
+	c.Assert(err, qt.IsNil)
+	c.Assert(v, qt.Equals, "97")
+
+	_, err = cast.ToStringE([]rune("héllo"))
+	c.Assert(err, qt.IsNotNil)
+}
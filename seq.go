@@ -0,0 +1,199 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+
+package cast
+
+import (
+	"fmt"
+	"iter"
+	"reflect"
+)
+
+// SeqOf returns an iterator converting the elements of a slice, array or iterator (e.g. [iter.Seq]) to T on demand.
+//
+// Every element is yielded along with its conversion error (if any) reported as an [IndexError],
+// so callers can stop early or skip invalid elements.
+// Values that cannot be iterated yield a single error.
+func SeqOf[T Basic](i any) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		i, _ := indirect(i)
+
+		switch v := i.(type) {
+		case []T:
+			for _, e := range v {
+				if !yield(e, nil) {
+					return
+				}
+			}
+
+			return
+		case []any:
+			for j, e := range v {
+				if !yield(toElementE[T](j, e)) {
+					return
+				}
+			}
+
+			return
+		}
+
+		seq, ok := valueSeq(i)
+		if !ok {
+			var t T
+
+			yield(t, fmt.Errorf(errorMsg, i, i, []T{}))
+
+			return
+		}
+
+		j := 0
+
+		for e := range seq {
+			if !yield(toElementE[T](j, e.Interface())) {
+				return
+			}
+
+			j++
+		}
+	}
+}
+
+// toElementE converts the element at index j, reporting failures as an [IndexError].
+func toElementE[T Basic](j int, e any) (T, error) {
+	v, err := ToE[T](e)
+	if err != nil {
+		return v, &IndexError{Index: j, Value: e, Err: err}
+	}
+
+	return v, nil
+}
+
+// MapSeqOfE returns an iterator converting the entries of a map, ordered map (see [ToOrderedStringMapE])
+// or key/value iterator (e.g. [iter.Seq2]) to K and V on demand.
+//
+// Every entry is yielded along with its conversion error (if any) reported as a [KeyError],
+// so callers can stop early or skip invalid entries.
+// Values that cannot be iterated yield a single error.
+func MapSeqOfE[K, V Basic](i any) iter.Seq2[KeyValue[K, V], error] {
+	return func(yield func(KeyValue[K, V], error) bool) {
+		i, _ := indirect(i)
+
+		seq, ok, err := entrySeq(i)
+		if !ok {
+			err = fmt.Errorf(errorMsg, i, i, map[K]V{})
+		}
+
+		if err != nil {
+			yield(KeyValue[K, V]{}, err)
+
+			return
+		}
+
+		for k, val := range seq {
+			key, value, err := convertEntry(k, val, ToE[K], ToE[V])
+			if !yield(KeyValue[K, V]{Key: key, Value: value}, err) {
+				return
+			}
+		}
+	}
+}
+
+// MapSeqOf returns an iterator converting the entries of a map, ordered map (see [ToOrderedStringMapE])
+// or key/value iterator (e.g. [iter.Seq2]) to K and V on demand.
+//
+// Entries that cannot be converted are skipped. Use [MapSeqOfE] to handle conversion errors.
+func MapSeqOf[K, V Basic](i any) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e, err := range MapSeqOfE[K, V](i) {
+			if err != nil {
+				continue
+			}
+
+			if !yield(e.Key, e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// valueSeq returns an iterator over the elements of slices, arrays and single value iterator functions.
+func valueSeq(i any) (iter.Seq[reflect.Value], bool) {
+	if i == nil {
+		return nil, false
+	}
+
+	v := reflect.ValueOf(i)
+
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		return func(yield func(reflect.Value) bool) {
+			for j := 0; j < v.Len(); j++ {
+				if !yield(v.Index(j)) {
+					return
+				}
+			}
+		}, true
+	case reflect.Func:
+		if v.IsNil() || !v.Type().CanSeq() {
+			return nil, false
+		}
+
+		return v.Seq(), true
+	default:
+		return nil, false
+	}
+}
+
+// entrySeq returns an iterator over the entries of maps, ordered maps, JSON objects and key/value iterator functions.
+func entrySeq(i any) (iter.Seq2[any, any], bool, error) {
+	if s, ok := i.(string); ok {
+		entries, err := jsonStringToOrderedObject(s)
+		if err != nil {
+			return nil, true, err
+		}
+
+		return keyValueSeq(entries), true, nil
+	}
+
+	if entries, ok := orderedEntries(i); ok {
+		return keyValueSeq(entries), true, nil
+	}
+
+	if i == nil {
+		return nil, false, nil
+	}
+
+	v := reflect.ValueOf(i)
+
+	switch v.Kind() {
+	case reflect.Map:
+	case reflect.Func:
+		if v.IsNil() || !v.Type().CanSeq2() {
+			return nil, false, nil
+		}
+	default:
+		return nil, false, nil
+	}
+
+	return func(yield func(any, any) bool) {
+		for k, val := range v.Seq2() {
+			if !yield(k.Interface(), val.Interface()) {
+				return
+			}
+		}
+	}, true, nil
+}
+
+func keyValueSeq(entries []KeyValue[any, any]) iter.Seq2[any, any] {
+	return func(yield func(any, any) bool) {
+		for _, e := range entries {
+			if !yield(e.Key, e.Value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+
+package cast_test
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"github.com/spf13/cast"
+)
+
+func TestSeqOf(t *testing.T) {
+	c := qt.New(t)
+
+	collect := func(i any) ([]int, []error) {
+		var values []int
+		var errs []error
+
+		for v, err := range cast.SeqOf[int](i) {
+			if err != nil {
+				errs = append(errs, err)
+
+				continue
+			}
+
+			values = append(values, v)
+		}
+
+		return values, errs
+	}
+
+	values, errs := collect([]any{1, "2", "banana", 4.0})
+	c.Assert(values, qt.DeepEquals, []int{1, 2, 4})
+	c.Assert(errs, qt.HasLen, 1)
+
+	var indexErr *cast.IndexError
+	c.Assert(errors.As(errs[0], &indexErr), qt.IsTrue)
+	c.Assert(indexErr.Index, qt.Equals, 2)
+
+	values, errs = collect([]int{1, 2, 3})
+	c.Assert(values, qt.DeepEquals, []int{1, 2, 3})
+	c.Assert(errs, qt.HasLen, 0)
+
+	values, errs = collect([2]string{"1", "2"})
+	c.Assert(values, qt.DeepEquals, []int{1, 2})
+	c.Assert(errs, qt.HasLen, 0)
+
+	values, errs = collect(slices.Values([]string{"1", "2"}))
+	c.Assert(values, qt.DeepEquals, []int{1, 2})
+	c.Assert(errs, qt.HasLen, 0)
+
+	values, errs = collect(&[]any{"1"})
+	c.Assert(values, qt.DeepEquals, []int{1})
+	c.Assert(errs, qt.HasLen, 0)
+
+	for _, input := range []any{nil, 1, testing.T{}} {
+		values, errs = collect(input)
+		c.Assert(values, qt.HasLen, 0)
+		c.Assert(errs, qt.HasLen, 1)
+	}
+}
+
+func TestSeqOfStop(t *testing.T) {
+	c := qt.New(t)
+
+	var values []int
+
+	for v, err := range cast.SeqOf[int]([]any{1, "banana", 3}) {
+		if err != nil {
+			break
+		}
+
+		values = append(values, v)
+	}
+
+	c.Assert(values, qt.DeepEquals, []int{1})
+}
+
+func TestMapSeqOfE(t *testing.T) {
+	c := qt.New(t)
+
+	var entries []cast.KeyValue[string, int]
+	var errs []error
+
+	for e, err := range cast.MapSeqOfE[string, int]([]mapItem{{"a", "1"}, {"b", "banana"}, {"c", 3}}) {
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		entries = append(entries, e)
+	}
+
+	c.Assert(entries, qt.DeepEquals, []cast.KeyValue[string, int]{{"a", 1}, {"c", 3}})
+	c.Assert(errs, qt.HasLen, 1)
+
+	var keyErr *cast.KeyError
+	c.Assert(errors.As(errs[0], &keyErr), qt.IsTrue)
+	c.Assert(keyErr.Key, qt.Equals, "b")
+
+	for _, input := range []any{nil, 1, `{"a": 1`} {
+		errs = nil
+
+		for _, err := range cast.MapSeqOfE[string, int](input) {
+			errs = append(errs, err)
+		}
+
+		c.Assert(errs, qt.HasLen, 1)
+		c.Assert(errs[0], qt.IsNotNil)
+	}
+}
+
+func TestMapSeqOf(t *testing.T) {
+	c := qt.New(t)
+
+	c.Assert(maps.Collect(cast.MapSeqOf[string, int](map[any]any{"a": "1", 2: 2.0, "c": "banana"})), qt.DeepEquals, map[string]int{"a": 1, "2": 2})
+	c.Assert(maps.Collect(cast.MapSeqOf[string, int](maps.All(map[MyString]string{"a": "1"}))), qt.DeepEquals, map[string]int{"a": 1})
+	c.Assert(maps.Collect(cast.MapSeqOf[string, bool](`{"a": true, "b": 0}`)), qt.DeepEquals, map[string]bool{"a": true, "b": false})
+}
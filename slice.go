@@ -7,10 +7,31 @@ package cast
 
 import (
 	"fmt"
+	"net"
 	"reflect"
 	"strings"
 )
 
+// IndexError records a failure to convert an element of a slice or array.
+type IndexError struct {
+	// Index is the position of the element in the original slice.
+	Index int
+
+	// Value is the original (unconverted) element.
+	Value any
+
+	// Err is the underlying conversion error.
+	Err error
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
 // ToSliceE casts any value to a []any type.
 func ToSliceE(i any) ([]any, error) {
 	i, _ = indirect(i)
@@ -32,8 +53,28 @@ func ToSliceE(i any) ([]any, error) {
 	}
 }
 
-func toSliceE[T Basic](i any) ([]T, error) {
-	v, ok, err := toSliceEOk[T](i)
+// ToSliceOfE casts any value to a []T type, converting elements using [ToWithE].
+//
+// Unlike the To*SliceE functions, it accepts [Option] values (e.g. [WithAllErrors]).
+// The options are also applied to the element conversions.
+func ToSliceOfE[T Basic](i any, opts ...Option) ([]T, error) {
+	return toSliceE[T](i, opts...)
+}
+
+// ToSliceOf casts any value to a []T type, converting elements using [ToE].
+func ToSliceOf[T Basic](i any) []T {
+	v, _ := ToSliceOfE[T](i)
+
+	return v
+}
+
+func toSliceE[T Basic](i any, opts ...Option) ([]T, error) {
+	return toSliceEWith(i, toFunc[T](opts), opts...)
+}
+
+// toSliceEWith is like toSliceE, but converts elements using fn.
+func toSliceEWith[T any](i any, fn func(any) (T, error), opts ...Option) ([]T, error) {
+	v, ok, err := toSliceEOkWith(i, fn, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -45,7 +86,12 @@ func toSliceE[T Basic](i any) ([]T, error) {
 	return v, nil
 }
 
-func toSliceEOk[T Basic](i any) ([]T, bool, error) {
+func toSliceEOk[T Basic](i any, opts ...Option) ([]T, bool, error) {
+	return toSliceEOkWith(i, toFunc[T](opts), opts...)
+}
+
+// toSliceEOkWith is like toSliceEOk, but converts elements using fn.
+func toSliceEOkWith[T any](i any, fn func(any) (T, error), opts ...Option) ([]T, bool, error) {
 	i, _ = indirect(i)
 	if i == nil {
 		return nil, true, fmt.Errorf(errorMsg, i, i, []T{})
@@ -60,47 +106,88 @@ func toSliceEOk[T Basic](i any) ([]T, bool, error) {
 	kind := reflect.TypeOf(i).Kind()
 	switch kind {
 	case reflect.Slice, reflect.Array:
+		c := newConfig(opts)
+
 		s := reflect.ValueOf(i)
 		a := make([]T, s.Len())
 
+		var errs []error
+
 		for j := 0; j < s.Len(); j++ {
-			val, err := ToE[T](s.Index(j).Interface())
+			e := s.Index(j).Interface()
+
+			val, err := fn(e)
 			if err != nil {
-				return nil, true, fmt.Errorf(errorMsg, i, i, []T{})
+				errs = append(errs, &IndexError{Index: j, Value: e, Err: err})
+
+				if !c.allErrors {
+					break
+				}
+
+				continue
 			}
 
 			a[j] = val
 		}
 
+		if err := wrapErrors(i, []T{}, errs); err != nil {
+			return nil, true, err
+		}
+
 		return a, true, nil
 	default:
 		return nil, false, nil
 	}
 }
 
-// ToStringSliceE casts any value to a []string type.
-func ToStringSliceE(i any) ([]string, error) {
-	if a, ok, err := toSliceEOk[string](i); ok {
-		if err != nil {
-			return nil, err
+// toStringlikeSliceE casts any value to a slice of values parsed from strings using fn.
+//
+// Like [ToStringSliceE], strings are split into fields, slices and arrays are converted element by element
+// and any other value becomes a single element slice.
+func toStringlikeSliceE[T any](i any, fn func(any) (T, error)) ([]T, error) {
+	// Values of type T are returned before dereferencing pointers, as T may be a pointer type (like *regexp.Regexp)
+	switch v := i.(type) {
+	case string:
+		// Strings are split into fields below, even if T is string
+	case T:
+		return []T{v}, nil
+	case []T:
+		return v, nil
+	}
+
+	// Pointers are dereferenced to find strings and slices, but other values are passed to fn as is
+	// (e.g. *regexp.Regexp implements fmt.Stringer, regexp.Regexp doesn't)
+	switch v, _ := indirect(i); v := v.(type) {
+	case string:
+		return toSliceEWith(strings.Fields(v), fn)
+	case net.IP:
+		// net.IP is a byte slice, but represents a single value
+	default:
+		if a, ok, err := toSliceEOkWith(v, fn); ok {
+			if err != nil {
+				return nil, err
+			}
+
+			return a, nil
 		}
+	}
 
-		return a, nil
+	v, err := fn(i)
+	if err != nil {
+		return nil, fmt.Errorf(errorMsgWith, i, i, []T{}, err)
 	}
 
-	var a []string
+	return []T{v}, nil
+}
 
-	switch v := i.(type) {
-	case string:
-		return strings.Fields(v), nil
-	case any:
-		str, err := ToStringE(v)
-		if err != nil {
-			return nil, fmt.Errorf(errorMsg, i, i, a)
+// ToStringSliceE casts any value to a []string type.
+func ToStringSliceE(i any) ([]string, error) {
+	// Unlike in the other string-like slice conversions, pointers to strings are not split into fields
+	if v, ok := indirect(i); ok {
+		if s, ok := v.(string); ok {
+			return []string{s}, nil
 		}
-
-		return []string{str}, nil
-	default:
-		return nil, fmt.Errorf(errorMsg, i, i, a)
 	}
+
+	return toStringlikeSliceE(i, ToStringE)
 }
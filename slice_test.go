@@ -265,6 +265,13 @@ func TestStringSlice(t *testing.T) {
 	}
 
 	runSliceTests(t, testCases, cast.ToStringSlice, cast.ToStringSliceE)
+
+	c := qt.New(t)
+
+	// Strings are split into fields, pointers to strings are not
+	s := "a b"
+	c.Assert(cast.ToStringSlice(s), qt.DeepEquals, []string{"a", "b"})
+	c.Assert(cast.ToStringSlice(&s), qt.DeepEquals, []string{"a b"})
 }
 
 func TestDurationSlice(t *testing.T) {
@@ -282,3 +289,51 @@ func TestDurationSlice(t *testing.T) {
 
 	runSliceTests(t, testCases, cast.ToDurationSlice, cast.ToDurationSliceE)
 }
+
+func TestSliceOf(t *testing.T) {
+	testCases := []testCase{
+		{[]int{1, 3}, []int{1, 3}, false},
+		{[]any{"1", 3.2}, []int{1, 3}, false},
+		{[2]string{"2", "3"}, []int{2, 3}, false},
+
+		// Failure cases
+		{nil, nil, true},
+		{testing.T{}, nil, true},
+		{[]string{"1", "foo"}, nil, true},
+	}
+
+	toErr := func(i any) ([]int, error) {
+		return cast.ToSliceOfE[int](i)
+	}
+
+	runSliceTests(t, testCases, cast.ToSliceOf[int], toErr)
+}
+
+func TestSliceErrors(t *testing.T) {
+	c := qt.New(t)
+
+	input := []any{1, "foo", 3, "bar"}
+
+	_, err := cast.ToIntSliceE(input)
+	c.Assert(err, qt.IsNotNil)
+
+	var indexErr *cast.IndexError
+	c.Assert(errors.As(err, &indexErr), qt.IsTrue)
+	c.Assert(indexErr.Index, qt.Equals, 1)
+	c.Assert(indexErr.Value, qt.Equals, "foo")
+	c.Assert(indexErr.Err, qt.ErrorMatches, `.*parsing "foo": invalid syntax`)
+
+	_, err = cast.ToSliceOfE[int](input, cast.WithAllErrors())
+	c.Assert(err, qt.IsNotNil)
+
+	joined, ok := errors.Unwrap(err).(interface{ Unwrap() []error })
+	c.Assert(ok, qt.IsTrue)
+
+	var indexes []int
+	for _, err := range joined.Unwrap() {
+		c.Assert(errors.As(err, &indexErr), qt.IsTrue)
+		indexes = append(indexes, indexErr.Index)
+	}
+
+	c.Assert(indexes, qt.DeepEquals, []int{1, 3})
+}
@@ -0,0 +1,42 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.22
+
+package cast
+
+import (
+	"database/sql"
+)
+
+// ToNullE casts any value to a [sql.Null] type.
+//
+// Nil values, nil pointers and invalid (NULL) [database/sql/driver.Valuer] values (like the sql.Null* types)
+// result in an invalid [sql.Null] value. Anything else is converted using [ToE].
+func ToNullE[T Basic](i any) (sql.Null[T], error) {
+	i, _ = indirect(i)
+
+	if v, ok := indirectValuer(i); ok {
+		i = v
+	}
+
+	if i == nil {
+		return sql.Null[T]{}, nil
+	}
+
+	v, err := ToE[T](i)
+	if err != nil {
+		return sql.Null[T]{}, err
+	}
+
+	return sql.Null[T]{V: v, Valid: true}, nil
+}
+
+// ToNull casts any value to a [sql.Null] type.
+func ToNull[T Basic](i any) sql.Null[T] {
+	v, _ := ToNullE[T](i)
+
+	return v
+}
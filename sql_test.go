@@ -0,0 +1,63 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.22
+
+package cast_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+
+	"github.com/spf13/cast"
+)
+
+func TestNull(t *testing.T) {
+	var ptr *int
+
+	testCases := []testCase{
+		{8, sql.Null[int]{V: 8, Valid: true}, false},
+		{"8", sql.Null[int]{V: 8, Valid: true}, false},
+		{0, sql.Null[int]{V: 0, Valid: true}, false},
+		{nil, sql.Null[int]{}, false},
+		{ptr, sql.Null[int]{}, false},
+		{sql.NullInt64{Int64: 8, Valid: true}, sql.Null[int]{V: 8, Valid: true}, false},
+		{sql.NullInt64{Int64: 8}, sql.Null[int]{}, false},
+		{sql.Null[string]{V: "8", Valid: true}, sql.Null[int]{V: 8, Valid: true}, false},
+
+		// Failure cases
+		{"test", sql.Null[int]{}, true},
+		{testing.T{}, sql.Null[int]{}, true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			c := qt.New(t)
+
+			v, err := cast.ToNullE[int](testCase.input)
+			if testCase.expectError {
+				c.Assert(err, qt.IsNotNil)
+			} else {
+				c.Assert(err, qt.IsNil)
+				c.Assert(v, qt.Equals, testCase.expected)
+			}
+
+			c.Assert(cast.ToNull[int](testCase.input), qt.Equals, v)
+		})
+	}
+}
+
+func TestNullTime(t *testing.T) {
+	c := qt.New(t)
+
+	v, err := cast.ToNullE[time.Time](sql.NullString{String: "2006-01-02", Valid: true})
+	c.Assert(err, qt.IsNil)
+	c.Assert(v, qt.Equals, sql.Null[time.Time]{V: time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), Valid: true})
+}
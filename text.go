@@ -0,0 +1,102 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast
+
+import (
+	"encoding"
+	"fmt"
+)
+
+// TextUnmarshaler is a type parameter constraint for pointers to types implementing [encoding.TextUnmarshaler].
+type TextUnmarshaler[T any] interface {
+	*T
+	encoding.TextUnmarshaler
+}
+
+// ToTextE casts any value to a pointer to a type implementing [encoding.TextUnmarshaler] (e.g. netip.Addr or big.Int).
+//
+// Pointers of type *T are returned as is and values of type T are copied (using MarshalText, if available,
+// so that types like big.Int do not share their internal state with the copy).
+// Anything else is converted using [ToStringE] first and then decoded using UnmarshalText.
+// Nil values result in a nil pointer.
+func ToTextE[T any, PT TextUnmarshaler[T]](i any) (PT, error) {
+	if p, ok := i.(PT); ok {
+		return p, nil
+	}
+
+	i, _ = indirect(i)
+
+	var t T
+
+	switch v := i.(type) {
+	case T:
+		p, err := cloneText[T, PT](v)
+		if err != nil {
+			return nil, fmt.Errorf(errorMsgWith, i, i, t, err)
+		}
+
+		return p, nil
+	case nil:
+		return nil, nil
+	}
+
+	s, err := ToStringE(i)
+	if err != nil {
+		return nil, fmt.Errorf(errorMsgWith, i, i, t, err)
+	}
+
+	if err := PT(&t).UnmarshalText([]byte(s)); err != nil {
+		return nil, fmt.Errorf(errorMsgWith, i, i, t, err)
+	}
+
+	return &t, nil
+}
+
+// ToText casts any value to a pointer to a type implementing [encoding.TextUnmarshaler].
+func ToText[T any, PT TextUnmarshaler[T]](i any) PT {
+	v, _ := ToTextE[T, PT](i)
+
+	return v
+}
+
+// cloneText returns a copy of v, using a MarshalText and UnmarshalText round trip if T implements [encoding.TextMarshaler].
+func cloneText[T any, PT TextUnmarshaler[T]](v T) (PT, error) {
+	m, ok := any(&v).(encoding.TextMarshaler)
+	if !ok {
+		return &v, nil
+	}
+
+	b, err := m.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	var t T
+
+	if err := PT(&t).UnmarshalText(b); err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// toTextValueE is like [ToTextE], but returns a value (for types that are safe to copy, like netip.Addr).
+func toTextValueE[T any, PT TextUnmarshaler[T]](i any) (T, error) {
+	var t T
+
+	i, _ = indirect(i)
+
+	if v, ok := i.(T); ok {
+		return v, nil
+	}
+
+	p, err := ToTextE[T, PT](i)
+	if err != nil || p == nil {
+		return t, err
+	}
+
+	return *p, nil
+}
@@ -0,0 +1,94 @@
+// Copyright © 2014 Steve Francia <spf@spf13.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package cast_test
+
+import (
+	"math/big"
+	"net/netip"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"github.com/spf13/cast"
+)
+
+func TestText(t *testing.T) {
+	t.Run("Addr", func(t *testing.T) {
+		c := qt.New(t)
+
+		addr := netip.MustParseAddr("192.168.0.1")
+
+		v, err := cast.ToTextE[netip.Addr]("192.168.0.1")
+		c.Assert(err, qt.IsNil)
+		c.Assert(*v, qt.Equals, addr)
+
+		v, err = cast.ToTextE[netip.Addr]([]byte("192.168.0.1"))
+		c.Assert(err, qt.IsNil)
+		c.Assert(*v, qt.Equals, addr)
+
+		v, err = cast.ToTextE[netip.Addr](addr)
+		c.Assert(err, qt.IsNil)
+		c.Assert(*v, qt.Equals, addr)
+
+		v, err = cast.ToTextE[netip.Addr](&addr)
+		c.Assert(err, qt.IsNil)
+		c.Assert(v, qt.Equals, &addr)
+
+		v, err = cast.ToTextE[netip.Addr](nil)
+		c.Assert(err, qt.IsNil)
+		c.Assert(v, qt.IsNil)
+
+		_, err = cast.ToTextE[netip.Addr]("banana")
+		c.Assert(err, qt.IsNotNil)
+
+		c.Assert(cast.ToText[netip.Addr]("banana"), qt.IsNil)
+	})
+
+	t.Run("Int", func(t *testing.T) {
+		c := qt.New(t)
+
+		v, err := cast.ToTextE[big.Int](12345678901234)
+		c.Assert(err, qt.IsNil)
+		c.Assert(v.String(), qt.Equals, "12345678901234")
+
+		v, err = cast.ToTextE[big.Int]("123456789012345678901234567890")
+		c.Assert(err, qt.IsNil)
+		c.Assert(v.String(), qt.Equals, "123456789012345678901234567890")
+
+		// Pointers are returned as is
+		n := big.NewInt(42)
+
+		v, err = cast.ToTextE[big.Int](n)
+		c.Assert(err, qt.IsNil)
+		c.Assert(v, qt.Equals, n)
+
+		// Values are copied without sharing their internal state
+		v, err = cast.ToTextE[big.Int](*n)
+		c.Assert(err, qt.IsNil)
+		c.Assert(v.String(), qt.Equals, "42")
+
+		v.SetInt64(1)
+		c.Assert(n.String(), qt.Equals, "42")
+
+		_, err = cast.ToTextE[big.Int](testing.T{})
+		c.Assert(err, qt.IsNotNil)
+	})
+
+	t.Run("Enum", func(t *testing.T) {
+		c := qt.New(t)
+
+		v, err := cast.ToTextE[level]("warning")
+		c.Assert(err, qt.IsNil)
+		c.Assert(*v, qt.Equals, level(2))
+
+		v, err = cast.ToTextE[level](level(1))
+		c.Assert(err, qt.IsNil)
+		c.Assert(*v, qt.Equals, level(1))
+
+		_, err = cast.ToTextE[level]("critical")
+		c.Assert(err, qt.IsNotNil)
+	})
+}
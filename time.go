@@ -56,6 +56,10 @@ func ToTimeInDefaultLocationE(i any, location *time.Location) (tim time.Time, er
 	case nil:
 		return time.Time{}, nil
 	default:
+		if i, ok := indirectValuer(i); ok {
+			return ToTimeInDefaultLocationE(i, location)
+		}
+
 		return time.Time{}, fmt.Errorf(errorMsg, i, i, time.Time{})
 	}
 }
@@ -96,6 +100,10 @@ func ToDurationE(i any) (time.Duration, error) {
 			return ToDurationE(i)
 		}
 
+		if i, ok := indirectValuer(i); ok {
+			return ToDurationE(i)
+		}
+
 		return 0, fmt.Errorf(errorMsg, i, i, time.Duration(0))
 	}
 }
@@ -6,6 +6,7 @@
 package cast_test
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"path"
@@ -60,6 +61,12 @@ func TestTime(t *testing.T) {
 
 		{ptr, time.Time{}, false},
 
+		// database/sql
+		{sql.NullTime{Time: time.Date(2009, 2, 13, 23, 31, 30, 0, time.UTC), Valid: true}, time.Date(2009, 2, 13, 23, 31, 30, 0, time.UTC), false},
+		{sql.NullTime{Time: time.Date(2009, 2, 13, 23, 31, 30, 0, time.UTC)}, time.Time{}, false},
+		{sql.NullString{String: "2006-01-02", Valid: true}, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), false},
+		{sql.NullInt64{Int64: 1234567890, Valid: true}, time.Date(2009, 2, 13, 23, 31, 30, 0, time.UTC), false},
+
 		// Failure cases
 		{"2006", time.Time{}, true},
 		{json.Number("123.4567890"), time.Time{}, true},
@@ -108,6 +115,11 @@ func TestDuration(t *testing.T) {
 		{MyString("5"), expected, false},
 		{MyDuration(5), expected, false},
 
+		// database/sql
+		{sql.NullInt64{Int64: 5, Valid: true}, expected, false},
+		{sql.NullString{String: "5", Valid: true}, expected, false},
+		{sql.NullString{String: "5"}, time.Duration(0), false},
+
 		// Failure cases
 		{"test", time.Duration(0), true},
 		{testing.T{}, time.Duration(0), true},
@@ -2,7 +2,14 @@
 
 package cast
 
-import "time"
+import (
+	"io/fs"
+	"net"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"time"
+)
 
 // ToBool casts any value to a(n) bool type.
 func ToBool(i any) bool {
@@ -106,6 +113,78 @@ func ToFloat64(i any) float64 {
 	return v
 }
 
+// ToComplex64 casts any value to a(n) complex64 type.
+func ToComplex64(i any) complex64 {
+	v, _ := ToComplex64E(i)
+	return v
+}
+
+// ToComplex128 casts any value to a(n) complex128 type.
+func ToComplex128(i any) complex128 {
+	v, _ := ToComplex128E(i)
+	return v
+}
+
+// ToRune casts any value to a(n) rune type.
+func ToRune(i any) rune {
+	v, _ := ToRuneE(i)
+	return v
+}
+
+// ToBytes casts any value to a(n) []byte type.
+func ToBytes(i any) []byte {
+	v, _ := ToBytesE(i)
+	return v
+}
+
+// ToIP casts any value to a(n) net.IP type.
+func ToIP(i any) net.IP {
+	v, _ := ToIPE(i)
+	return v
+}
+
+// ToAddr casts any value to a(n) netip.Addr type.
+func ToAddr(i any) netip.Addr {
+	v, _ := ToAddrE(i)
+	return v
+}
+
+// ToPrefix casts any value to a(n) netip.Prefix type.
+func ToPrefix(i any) netip.Prefix {
+	v, _ := ToPrefixE(i)
+	return v
+}
+
+// ToAddrPort casts any value to a(n) netip.AddrPort type.
+func ToAddrPort(i any) netip.AddrPort {
+	v, _ := ToAddrPortE(i)
+	return v
+}
+
+// ToURL casts any value to a(n) *url.URL type.
+func ToURL(i any) *url.URL {
+	v, _ := ToURLE(i)
+	return v
+}
+
+// ToRegexp casts any value to a(n) *regexp.Regexp type.
+func ToRegexp(i any) *regexp.Regexp {
+	v, _ := ToRegexpE(i)
+	return v
+}
+
+// ToGlob casts any value to a(n) *regexp.Regexp type.
+func ToGlob(i any) *regexp.Regexp {
+	v, _ := ToGlobE(i)
+	return v
+}
+
+// ToFileMode casts any value to a(n) fs.FileMode type.
+func ToFileMode(i any) fs.FileMode {
+	v, _ := ToFileModeE(i)
+	return v
+}
+
 // ToStringMapString casts any value to a(n) map[string]string type.
 func ToStringMapString(i any) map[string]string {
 	v, _ := ToStringMapStringE(i)
@@ -142,6 +221,12 @@ func ToStringMap(i any) map[string]any {
 	return v
 }
 
+// ToOrderedStringMap casts any value to a(n) []KeyValue[string, any] type.
+func ToOrderedStringMap(i any) []KeyValue[string, any] {
+	v, _ := ToOrderedStringMapE(i)
+	return v
+}
+
 // ToSlice casts any value to a(n) []any type.
 func ToSlice(i any) []any {
 	v, _ := ToSliceE(i)
@@ -190,6 +275,54 @@ func ToDurationSlice(i any) []time.Duration {
 	return v
 }
 
+// ToRuneSlice casts any value to a(n) []rune type.
+func ToRuneSlice(i any) []rune {
+	v, _ := ToRuneSliceE(i)
+	return v
+}
+
+// ToIPSlice casts any value to a(n) []net.IP type.
+func ToIPSlice(i any) []net.IP {
+	v, _ := ToIPSliceE(i)
+	return v
+}
+
+// ToAddrSlice casts any value to a(n) []netip.Addr type.
+func ToAddrSlice(i any) []netip.Addr {
+	v, _ := ToAddrSliceE(i)
+	return v
+}
+
+// ToPrefixSlice casts any value to a(n) []netip.Prefix type.
+func ToPrefixSlice(i any) []netip.Prefix {
+	v, _ := ToPrefixSliceE(i)
+	return v
+}
+
+// ToAddrPortSlice casts any value to a(n) []netip.AddrPort type.
+func ToAddrPortSlice(i any) []netip.AddrPort {
+	v, _ := ToAddrPortSliceE(i)
+	return v
+}
+
+// ToURLSlice casts any value to a(n) []*url.URL type.
+func ToURLSlice(i any) []*url.URL {
+	v, _ := ToURLSliceE(i)
+	return v
+}
+
+// ToRegexpSlice casts any value to a(n) []*regexp.Regexp type.
+func ToRegexpSlice(i any) []*regexp.Regexp {
+	v, _ := ToRegexpSliceE(i)
+	return v
+}
+
+// ToGlobSlice casts any value to a(n) []*regexp.Regexp type.
+func ToGlobSlice(i any) []*regexp.Regexp {
+	v, _ := ToGlobSliceE(i)
+	return v
+}
+
 // ToBoolSliceE casts any value to a(n) []bool type.
 func ToBoolSliceE(i any) ([]bool, error) {
 	return toSliceE[bool](i)